@@ -0,0 +1,222 @@
+// Command rpc-gen-markdown is an example external generator, run with
+// `rpc -gen exec:rpc-gen-markdown -out docs todo.rpc`. It reads the spec from stdin, in
+// the same form as `rpc -parse` prints it, and responds on stdout with an API.md file
+// describing its namespaces, types, enums and rpcs.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type (
+	pos struct {
+		File string `json:"file"`
+		Line int    `json:"line_no"`
+	}
+
+	typeRef struct {
+		Name      string     `json:"name"`
+		Arguments []*typeRef `json:"arguments"`
+	}
+
+	property struct {
+		Name string   `json:"name"`
+		Doc  string   `json:"doc"`
+		Type *typeRef `json:"type"`
+	}
+
+	typ struct {
+		Name       string               `json:"name"`
+		Pos        pos                  `json:"pos"`
+		Doc        string               `json:"doc"`
+		Properties map[string]*property `json:"properties"`
+	}
+
+	enum struct {
+		Name    string   `json:"name"`
+		Pos     pos      `json:"pos"`
+		Doc     string   `json:"doc"`
+		Members []string `json:"members"`
+	}
+
+	rpc struct {
+		Name       string     `json:"name"`
+		Pos        pos        `json:"pos"`
+		Doc        string     `json:"doc"`
+		Input      []*typeRef `json:"input"`
+		InputNames []string   `json:"input_names"`
+		Output     []*typeRef `json:"output"`
+		Errors     []*typeRef `json:"errors"`
+	}
+
+	namespace struct {
+		Name     string                 `json:"name"`
+		Doc      string                 `json:"doc"`
+		Children map[string]*namespace  `json:"children"`
+		Options  map[string]interface{} `json:"options"`
+		Types    map[string]*typ        `json:"types"`
+		Enums    map[string]*enum       `json:"enums"`
+		Errors   map[string]*typ        `json:"errors"`
+		RPCs     map[string]*rpc        `json:"rpcs"`
+	}
+
+	file struct {
+		Path    string `json:"path"`
+		Content string `json:"content"`
+	}
+
+	response struct {
+		Files []*file `json:"files"`
+	}
+)
+
+func main() {
+	root := &namespace{}
+	if err := json.NewDecoder(os.Stdin).Decode(root); err != nil {
+		fmt.Fprintln(os.Stderr, "rpc-gen-markdown: reading spec:", err)
+		os.Exit(1)
+	}
+
+	sb := &strings.Builder{}
+	title := "API"
+	if value, ok := root.Options["markdown_title"]; ok {
+		title = fmt.Sprint(value)
+	}
+	sb.WriteString("# " + title + "\n")
+	writeNamespace(sb, root, "")
+
+	resp := &response{Files: []*file{{Path: "API.md", Content: sb.String()}}}
+	if err := json.NewEncoder(os.Stdout).Encode(resp); err != nil {
+		fmt.Fprintln(os.Stderr, "rpc-gen-markdown: writing response:", err)
+		os.Exit(1)
+	}
+}
+
+func writeNamespace(sb *strings.Builder, ns *namespace, path string) {
+	if path != "" {
+		sb.WriteString("\n## " + path + "\n")
+	}
+	if ns.Doc != "" {
+		sb.WriteString("\n" + ns.Doc + "\n")
+	}
+
+	for _, name := range sortedKeys(ns.Types) {
+		writeType(sb, "Type", ns.Types[name])
+	}
+	for _, name := range sortedKeys(ns.Errors) {
+		writeType(sb, "Error", ns.Errors[name])
+	}
+	for _, name := range sortedKeys(ns.Enums) {
+		e := ns.Enums[name]
+		sb.WriteString("\n### Enum `" + e.Name + "`" + describe(e.Doc, e.Pos) + "\n\n")
+		for _, member := range e.Members {
+			sb.WriteString("* `" + member + "`\n")
+		}
+	}
+	for _, name := range sortedKeys(ns.RPCs) {
+		r := ns.RPCs[name]
+		var args, returns, errs []string
+		for idx, ref := range r.Input {
+			arg := format(ref)
+			if idx < len(r.InputNames) && r.InputNames[idx] != "" {
+				arg += " " + r.InputNames[idx]
+			}
+			args = append(args, arg)
+		}
+		for _, ref := range r.Output {
+			returns = append(returns, format(ref))
+		}
+		for _, ref := range r.Errors {
+			errs = append(errs, ref.Name)
+		}
+
+		signature := "rpc " + r.Name + "(" + strings.Join(args, ", ") + ")"
+		if len(returns) > 0 {
+			signature += " " + strings.Join(returns, ", ")
+		}
+		if len(errs) > 0 {
+			signature += " throws " + strings.Join(errs, ", ")
+		}
+		sb.WriteString("\n### RPC `" + r.Name + "`" + describe(r.Doc, r.Pos) + "\n\n")
+		sb.WriteString("```\n" + signature + "\n```\n")
+	}
+
+	for _, name := range sortedKeys(ns.Children) {
+		child := ns.Children[name]
+		childPath := child.Name
+		if path != "" {
+			childPath = path + "." + child.Name
+		}
+		writeNamespace(sb, child, childPath)
+	}
+}
+
+func writeType(sb *strings.Builder, kind string, t *typ) {
+	sb.WriteString("\n### " + kind + " `" + t.Name + "`" + describe(t.Doc, t.Pos) + "\n")
+	if len(t.Properties) == 0 {
+		return
+	}
+
+	sb.WriteString("\n| Property | Type | Description |\n|---|---|---|\n")
+	for _, propName := range sortedKeys(t.Properties) {
+		prop := t.Properties[propName]
+		doc := strings.ReplaceAll(prop.Doc, "\n", " ")
+		sb.WriteString("| `" + prop.Name + "` | `" + format(prop.Type) + "` | " + doc + " |\n")
+	}
+}
+
+// describe returns the position of a declaration, followed by its doc.
+func describe(doc string, p pos) string {
+	desc := fmt.Sprintf("\n\nDeclared in %s, line %d.", filepath.Base(p.File), p.Line)
+	if doc != "" {
+		desc += "\n\n" + doc
+	}
+	return desc
+}
+
+func format(ref *typeRef) string {
+	if ref == nil {
+		return ""
+	} else if len(ref.Arguments) == 0 {
+		return ref.Name
+	}
+
+	var args []string
+	for _, arg := range ref.Arguments {
+		args = append(args, format(arg))
+	}
+	return ref.Name + "<" + strings.Join(args, ", ") + ">"
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]*typ:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]*enum:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]*rpc:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]*namespace:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]*property:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -8,7 +8,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	rpc_root "github.com/chakrit/rpc/todo/api"
@@ -32,12 +34,13 @@ func (c *Client_rpc_root) initialize(client *Client) {
 
 func (c Client_rpc_root) Create(
 	ctx context.Context,
-	arg0 string,
+	description string,
 ) (
 	out0 *rpc_root.TodoItem,
 	err error,
 ) {
-	payload := []interface{}{arg0}
+	const method = "api/Create"
+	payload := []interface{}{description}
 
 	buf := &bytes.Buffer{}
 	if err = json.NewEncoder(buf).Encode(payload); err != nil {
@@ -45,29 +48,26 @@ func (c Client_rpc_root) Create(
 	}
 
 	var req *http.Request
-	req, err = http.NewRequest("POST", "http://"+c.Client.Options.Addr+"/api/Create", buf)
+	req, err = http.NewRequest("POST", c.Client.baseURL+"/api/Create", buf)
 	if err != nil {
 		return
 	}
 
 	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
 
 	var resp *http.Response
-	resp, err = c.HTTPClient.Do(req)
+	resp, err = c.Client.do(ctx, method, req)
 	if err != nil {
+		err = &TransportError{Method: method, Err: err}
 		return
 	}
 
 	returns := [1]interface{}{&out0}
 	result := &Result{}
 	result.Returns = returns[:]
-
-	if resp.Body != nil {
-		defer resp.Body.Close()
-
-		if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return
-		}
+	if err = decodeResult(method, resp, result); err != nil {
+		return
 	}
 
 	if result.Error != nil {
@@ -77,12 +77,13 @@ func (c Client_rpc_root) Create(
 }
 func (c Client_rpc_root) Destroy(
 	ctx context.Context,
-	arg0 int64,
+	id int64,
 ) (
 	out0 *rpc_root.TodoItem,
 	err error,
 ) {
-	payload := []interface{}{arg0}
+	const method = "api/Destroy"
+	payload := []interface{}{id}
 
 	buf := &bytes.Buffer{}
 	if err = json.NewEncoder(buf).Encode(payload); err != nil {
@@ -90,29 +91,26 @@ func (c Client_rpc_root) Destroy(
 	}
 
 	var req *http.Request
-	req, err = http.NewRequest("POST", "http://"+c.Client.Options.Addr+"/api/Destroy", buf)
+	req, err = http.NewRequest("POST", c.Client.baseURL+"/api/Destroy", buf)
 	if err != nil {
 		return
 	}
 
 	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
 
 	var resp *http.Response
-	resp, err = c.HTTPClient.Do(req)
+	resp, err = c.Client.do(ctx, method, req)
 	if err != nil {
+		err = &TransportError{Method: method, Err: err}
 		return
 	}
 
 	returns := [1]interface{}{&out0}
 	result := &Result{}
 	result.Returns = returns[:]
-
-	if resp.Body != nil {
-		defer resp.Body.Close()
-
-		if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return
-		}
+	if err = decodeResult(method, resp, result); err != nil {
+		return
 	}
 
 	if result.Error != nil {
@@ -120,12 +118,15 @@ func (c Client_rpc_root) Destroy(
 	}
 	return
 }
+
+// List returns all todo items.
 func (c Client_rpc_root) List(
 	ctx context.Context,
 ) (
 	out0 []*rpc_root.TodoItem,
 	err error,
 ) {
+	const method = "api/List"
 	payload := []interface{}{}
 
 	buf := &bytes.Buffer{}
@@ -134,29 +135,26 @@ func (c Client_rpc_root) List(
 	}
 
 	var req *http.Request
-	req, err = http.NewRequest("POST", "http://"+c.Client.Options.Addr+"/api/List", buf)
+	req, err = http.NewRequest("POST", c.Client.baseURL+"/api/List", buf)
 	if err != nil {
 		return
 	}
 
 	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
 
 	var resp *http.Response
-	resp, err = c.HTTPClient.Do(req)
+	resp, err = c.Client.do(ctx, method, req)
 	if err != nil {
+		err = &TransportError{Method: method, Err: err}
 		return
 	}
 
 	returns := [1]interface{}{&out0}
 	result := &Result{}
 	result.Returns = returns[:]
-
-	if resp.Body != nil {
-		defer resp.Body.Close()
-
-		if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return
-		}
+	if err = decodeResult(method, resp, result); err != nil {
+		return
 	}
 
 	if result.Error != nil {
@@ -166,13 +164,14 @@ func (c Client_rpc_root) List(
 }
 func (c Client_rpc_root) UpdateState(
 	ctx context.Context,
-	arg0 int64,
-	arg1 rpc_root.State,
+	id int64,
+	state rpc_root.State,
 ) (
 	out0 *rpc_root.TodoItem,
 	err error,
 ) {
-	payload := []interface{}{arg0, arg1}
+	const method = "api/UpdateState"
+	payload := []interface{}{id, state}
 
 	buf := &bytes.Buffer{}
 	if err = json.NewEncoder(buf).Encode(payload); err != nil {
@@ -180,29 +179,26 @@ func (c Client_rpc_root) UpdateState(
 	}
 
 	var req *http.Request
-	req, err = http.NewRequest("POST", "http://"+c.Client.Options.Addr+"/api/UpdateState", buf)
+	req, err = http.NewRequest("POST", c.Client.baseURL+"/api/UpdateState", buf)
 	if err != nil {
 		return
 	}
 
 	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
 
 	var resp *http.Response
-	resp, err = c.HTTPClient.Do(req)
+	resp, err = c.Client.do(ctx, method, req)
 	if err != nil {
+		err = &TransportError{Method: method, Err: err}
 		return
 	}
 
 	returns := [1]interface{}{&out0}
 	result := &Result{}
 	result.Returns = returns[:]
-
-	if resp.Body != nil {
-		defer resp.Body.Close()
-
-		if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return
-		}
+	if err = decodeResult(method, resp, result); err != nil {
+		return
 	}
 
 	if result.Error != nil {
@@ -212,27 +208,199 @@ func (c Client_rpc_root) UpdateState(
 }
 
 type Result struct {
-	Error   error         `json:"error"`
+	Error   *RPCError     `json:"error"`
 	Returns []interface{} `json:"returns"`
 }
 
+// Well-known error codes for failures that are not declared in the spec.
+const (
+	CodeInvalidArgument  = "invalid_argument"
+	CodeUnauthenticated  = "unauthenticated"
+	CodePermissionDenied = "permission_denied"
+	CodeNotFound         = "not_found"
+	CodeInternal         = "internal"
+)
+
+// RPCError is an error reported by the server. Errors declared for the rpc are decoded
+// from the details into Err, which errors.As reaches through the RPCError. Responses
+// without a readable error in the body, such as those from proxies in front of the
+// server, are reported with a code derived from the HTTP status.
+type RPCError struct {
+	Method  string          `json:"-"`
+	Status  int             `json:"-"`
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Details json.RawMessage `json:"details,omitempty"`
+	Err     error           `json:"-"`
+}
+
+func (e *RPCError) Error() string {
+	return e.Method + ": " + e.Message
+}
+
+func (e *RPCError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalJSON also accepts errors sent as plain strings by older servers.
+func (e *RPCError) UnmarshalJSON(buf []byte) error {
+	var message string
+	if err := json.Unmarshal(buf, &message); err == nil {
+		*e = RPCError{Code: CodeInternal, Message: message}
+		return nil
+	}
+
+	type shim RPCError
+	return json.Unmarshal(buf, (*shim)(e))
+}
+
+// TransportError is returned when the request could not be sent or no response was
+// received. The underlying error is usually a *url.Error.
+type TransportError struct {
+	Method string
+	Err    error
+}
+
+func (e *TransportError) Error() string {
+	return e.Method + ": " + e.Err.Error()
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError is returned when a successful response could not be decoded, usually
+// because the client and server were generated from different specs.
+type DecodeError struct {
+	Method string
+	Status int
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%s: decoding %d response: %s", e.Method, e.Status, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func decodeResult(method string, resp *http.Response, result *Result) error {
+	defer resp.Body.Close()
+
+	err := json.NewDecoder(resp.Body).Decode(result)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if err != nil || result.Error == nil {
+			err, result.Error = nil, &RPCError{Code: codeForStatus(resp.StatusCode), Message: resp.Status}
+		}
+	}
+
+	if err != nil {
+		return &DecodeError{Method: method, Status: resp.StatusCode, Err: err}
+	} else if result.Error != nil {
+		result.Error.Method, result.Error.Status = method, resp.StatusCode
+	}
+	return nil
+}
+
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeInvalidArgument
+	case http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case http.StatusForbidden:
+		return CodePermissionDenied
+	case http.StatusNotFound:
+		return CodeNotFound
+	default:
+		return CodeInternal
+	}
+}
+
 type Client struct {
 	Options
 	Client_rpc_root
 
 	HTTPClient *http.Client
+	baseURL    string
 }
 
+// DefaultTimeout limits calls made by clients created without a Timeout or HTTPClient.
+const DefaultTimeout = 30 * time.Second
+
+// Options configures the client. BaseURL, such as `https://example.com/api`, takes
+// precedence over Addr which is called over plain HTTP. HTTPClient is used as-is when set,
+// otherwise one is created from Transport and Timeout. A negative Timeout disables it.
 type Options struct {
-	Addr string
+	Addr         string
+	BaseURL      string
+	HTTPClient   *http.Client
+	Transport    http.RoundTripper
+	Timeout      time.Duration
+	Interceptors []Interceptor
+}
+
+// Invoker sends the request for an rpc and returns the server's response.
+type Invoker func(ctx context.Context, method string, req *http.Request) (*http.Response, error)
+
+// Interceptor wraps every call made by the client, in the order given in Options, to add
+// headers, logging or metrics. It must call next to continue with the call.
+type Interceptor func(ctx context.Context, method string, req *http.Request, next Invoker) (*http.Response, error)
+
+type headerKey struct{}
+
+// WithHeader returns a context that adds the header to requests made with it, on top of
+// the headers added by earlier calls to WithHeader.
+func WithHeader(ctx context.Context, key, value string) context.Context {
+	header := http.Header{}
+	if existing, ok := ctx.Value(headerKey{}).(http.Header); ok {
+		header = existing.Clone()
+	}
+	header.Add(key, value)
+	return context.WithValue(ctx, headerKey{}, header)
 }
 
 func New(opts *Options) *Client {
 	client := &Client{
 		Options:         *opts,
 		Client_rpc_root: Client_rpc_root{},
-		HTTPClient:      &http.Client{},
+		HTTPClient:      opts.HTTPClient,
+		baseURL:         strings.TrimSuffix(opts.BaseURL, "/"),
+	}
+	if client.baseURL == "" {
+		client.baseURL = "http://" + opts.Addr
+	}
+	if client.HTTPClient == nil {
+		timeout := opts.Timeout
+		if timeout == 0 {
+			timeout = DefaultTimeout
+		} else if timeout < 0 {
+			timeout = 0
+		}
+		client.HTTPClient = &http.Client{Transport: opts.Transport, Timeout: timeout}
 	}
 	client.Client_rpc_root.initialize(client)
 	return client
 }
+
+func (c *Client) do(ctx context.Context, method string, req *http.Request) (*http.Response, error) {
+	if header, ok := ctx.Value(headerKey{}).(http.Header); ok {
+		for key, values := range header {
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+	}
+
+	invoke := func(_ context.Context, _ string, req *http.Request) (*http.Response, error) {
+		return c.HTTPClient.Do(req)
+	}
+	for idx := len(c.Interceptors) - 1; idx >= 0; idx-- {
+		interceptor, next := c.Interceptors[idx], invoke
+		invoke = func(ctx context.Context, method string, req *http.Request) (*http.Response, error) {
+			return interceptor(ctx, method, req, next)
+		}
+	}
+	return invoke(ctx, method, req)
+}
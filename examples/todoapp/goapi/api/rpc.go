@@ -7,6 +7,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math"
 
 	time "time"
@@ -15,10 +16,13 @@ import (
 var (
 	_ context.Context = nil
 	_                 = json.Marshal
+	_                 = fmt.Errorf
 	_                 = math.Pi
 )
 
+// TodoItem is a single entry in the todo list.
 type TodoItem struct {
+	// creation time of the item
 	Ctime       time.Time `json:"ctime" yaml:"ctime" db:"ctime"`
 	Description string    `json:"description" yaml:"description" db:"description"`
 	ID          int64     `json:"id" yaml:"id" db:"id"`
@@ -32,7 +36,7 @@ func (obj *TodoItem) MarshalJSON() ([]byte, error) {
 		Description string  `json:"description"`
 		ID          int64   `json:"id"`
 		Metadata    []byte  `json:"metadata"`
-		State       string  `json:"state"`
+		State       State   `json:"state"`
 	}{
 		Ctime: (func(t time.Time) float64 {
 			sec, nsec := t.Unix(), t.Nanosecond()
@@ -41,7 +45,7 @@ func (obj *TodoItem) MarshalJSON() ([]byte, error) {
 		Description: (obj.Description),
 		ID:          (obj.ID),
 		Metadata:    (obj.Metadata),
-		State:       (func(v State) string { return string(v) })(obj.State),
+		State:       (obj.State),
 	}
 	return json.Marshal(outobj)
 }
@@ -52,7 +56,7 @@ func (obj *TodoItem) UnmarshalJSON(buf []byte) error {
 		Description string  `json:"description"`
 		ID          int64   `json:"id"`
 		Metadata    []byte  `json:"metadata"`
-		State       string  `json:"state"`
+		State       State   `json:"state"`
 	}{}
 
 	if err := json.Unmarshal(buf, &inobj); err != nil {
@@ -67,26 +71,67 @@ func (obj *TodoItem) UnmarshalJSON(buf []byte) error {
 	obj.Description = (inobj.Description)
 	obj.ID = (inobj.ID)
 	obj.Metadata = (inobj.Metadata)
-	obj.State = (func(v string) State { return State(v) })(inobj.State)
+	obj.State = (inobj.State)
 	return nil
 }
 
+// State tracks the progress of a TodoItem.
 type State string
 
 const (
 	StateNew        = State("new")
 	StateInProgress = State("in-progress")
-	StateOverdue    = State("overdue")
-	StateCompleted  = State("completed")
+	// Overdue items are past their due date and not yet completed.
+	StateOverdue   = State("overdue")
+	StateCompleted = State("completed")
 )
 
+// Values returns all members of State, in the order they are declared.
+func (State) Values() []State {
+	return []State{
+		StateNew,
+		StateInProgress,
+		StateOverdue,
+		StateCompleted,
+	}
+}
+
+// IsValid reports whether v is one of the declared members of State.
+func (v State) IsValid() bool {
+	switch v {
+	case StateNew, StateInProgress, StateOverdue, StateCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+func (v State) String() string {
+	return string(v)
+}
+
+func (v *State) UnmarshalJSON(buf []byte) error {
+	var raw string
+	if err := json.Unmarshal(buf, &raw); err != nil {
+		return err
+	}
+
+	if value := State(raw); value.IsValid() {
+		*v = value
+	} else {
+		return fmt.Errorf("invalid State value %s", buf)
+	}
+	return nil
+}
+
 type Interface interface {
-	Create(context.Context, string) (*TodoItem, error,
+	Create(ctx context.Context, description string) (*TodoItem, error,
 	)
-	Destroy(context.Context, int64) (*TodoItem, error,
+	Destroy(ctx context.Context, id int64) (*TodoItem, error,
 	)
-	List(context.Context) ([]*TodoItem, error,
+	// List returns all todo items.
+	List(ctx context.Context) ([]*TodoItem, error,
 	)
-	UpdateState(context.Context, int64, State) (*TodoItem, error,
+	UpdateState(ctx context.Context, id int64, state State) (*TodoItem, error,
 	)
 }
@@ -5,13 +5,29 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net"
 	"net/http"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
 
 	rpc_root "github.com/chakrit/rpc/todo/api"
 )
 
+var (
+	_ context.Context = nil
+	_ time.Time       = time.Time{}
+)
+
 type Provider_rpc_root interface {
 	Provide_rpc_root() rpc_root.Interface
 }
@@ -21,17 +37,152 @@ type Result struct {
 	Returns []interface{} `json:"returns"`
 }
 
+// Well-known error codes for failures that are not declared in the spec. Handlers return
+// them with Errorf.
+const (
+	CodeInvalidArgument  = "invalid_argument"
+	CodeUnauthenticated  = "unauthenticated"
+	CodePermissionDenied = "permission_denied"
+	CodeNotFound         = "not_found"
+	CodeInternal         = "internal"
+)
+
+// Error is the wire representation of errors returned from handlers. Errors declared in
+// the spec carry their own code and are sent along as details.
+type Error struct {
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// Errorf returns an error with the given code and a formatted message.
+func Errorf(code string, format string, args ...interface{}) error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) ErrorCode() string {
+	return e.Code
+}
+
+// DefaultStatusFor maps errors to HTTP statuses. Errors declared with `@status` in the
+// spec use that status, well-known codes map to their usual statuses, other declared
+// errors to 400 and everything else to 500.
+func DefaultStatusFor(err error) int {
+	var withStatus interface{ HTTPStatus() int }
+	if errors.As(err, &withStatus) {
+		return withStatus.HTTPStatus()
+	}
+
+	var coded interface{ ErrorCode() string }
+	if !errors.As(err, &coded) {
+		return http.StatusInternalServerError
+	}
+
+	switch coded.ErrorCode() {
+	case CodeInvalidArgument:
+		return http.StatusBadRequest
+	case CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case CodePermissionDenied:
+		return http.StatusForbidden
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeInternal:
+		return http.StatusInternalServerError
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// MethodInfo describes the rpc being called, for interceptors.
+type MethodInfo struct {
+	Namespace   string
+	Name        string
+	Args        []interface{}
+	Annotations Annotations
+}
+
+// Method returns the path that identifies the rpc, as given to CtxFilter and ErrFilter.
+func (info *MethodInfo) Method() string {
+	return info.Namespace + "/" + info.Name
+}
+
+// Annotation is an `@name(args...)` annotation placed on the rpc in the spec.
+type Annotation struct {
+	Name   string
+	Args   []string
+	Params map[string]string
+}
+
+type Annotations []Annotation
+
+// Lookup returns the first annotation with the given name, or nil if there is none.
+func (a Annotations) Lookup(name string) *Annotation {
+	for idx := range a {
+		if a[idx].Name == name {
+			return &a[idx]
+		}
+	}
+	return nil
+}
+
+func (a Annotations) Has(name string) bool {
+	return a.Lookup(name) != nil
+}
+
+// Handler calls the rpc described by the MethodInfo and returns its results.
+type Handler func(ctx context.Context, info *MethodInfo) ([]interface{}, error)
+
+// Interceptor wraps every call to the handlers, in the order given in Options, for
+// authorization, rate limiting or logging. It may return an error without calling next
+// to stop the call.
+type Interceptor func(ctx context.Context, info *MethodInfo, next Handler) ([]interface{}, error)
+
+// PanicError is returned in place of the results of a handler or interceptor that
+// panicked. It is sent to clients as an internal error with a fixed message, leaving
+// the value and stack to interceptors and ErrLog.
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprint("panic: ", e.Value)
+}
+
 type Server struct {
 	options  Options
 	Provider Provider_rpc_root
+
+	mutex      sync.Mutex
+	httpServer *http.Server
 }
 
 type Options struct {
 	Addr      string
+	Prefix    string
 	CtxFilter func(req *http.Request, method string) context.Context
 	ErrFilter func(req *http.Request, method string, err error) error
 	ErrLog    func(req *http.Request, method string, err error)
 	FormatErr func(err error) string
+	StatusFor func(err error) int
+
+	Interceptors []Interceptor
+
+	// Timeouts for the http.Server used by Listen, ListenTLS and Serve, and the limit on
+	// request body sizes. Zero values leave them unlimited.
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+	MaxBodyBytes int64
+
+	// Lenient accepts calls made with any HTTP method and content type, instead of only
+	// POST requests with an application/json body.
+	Lenient bool
 }
 
 func New(opts *Options) *Server {
@@ -46,16 +197,75 @@ func New(opts *Options) *Server {
 			return err
 		}
 	}
+	if srv.options.StatusFor == nil {
+		srv.options.StatusFor = DefaultStatusFor
+	}
 	return srv
 }
 
+// Listen serves the rpcs on Options.Addr until Shutdown is called, after which it returns
+// http.ErrServerClosed.
 func (s *Server) Listen() error {
-	return http.ListenAndServe(s.options.Addr, s.HTTPHandler())
+	return s.server().ListenAndServe()
+}
+
+// ListenTLS is like Listen but serves HTTPS using the given certificate and key files.
+func (s *Server) ListenTLS(certFile, keyFile string) error {
+	return s.server().ListenAndServeTLS(certFile, keyFile)
 }
 
+// Serve serves the rpcs on connections accepted from the listener, until Shutdown is
+// called.
+func (s *Server) Serve(listener net.Listener) error {
+	return s.server().Serve(listener)
+}
+
+// Shutdown stops accepting new connections and waits for calls in progress to finish or
+// for the context to be done, whichever comes first.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.server().Shutdown(ctx)
+}
+
+func (s *Server) server() *http.Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.httpServer == nil {
+		s.httpServer = &http.Server{
+			Addr:         s.options.Addr,
+			Handler:      s.HTTPHandler(),
+			ReadTimeout:  s.options.ReadTimeout,
+			WriteTimeout: s.options.WriteTimeout,
+			IdleTimeout:  s.options.IdleTimeout,
+		}
+	}
+	return s.httpServer
+}
+
+func (s *Server) invoke(ctx context.Context, info *MethodInfo, handler Handler) (returns []interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			returns, err = nil, &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	for idx := len(s.options.Interceptors) - 1; idx >= 0; idx-- {
+		interceptor, next := s.options.Interceptors[idx], handler
+		handler = func(ctx context.Context, info *MethodInfo) ([]interface{}, error) {
+			return interceptor(ctx, info, next)
+		}
+	}
+	return handler(ctx, info)
+}
+
+// HTTPHandler returns a handler that serves the rpcs. With a Prefix set, such as `/api`,
+// it serves them under that path so it can be mounted alongside other handlers.
 func (s *Server) HTTPHandler() http.Handler {
 	mux := http.NewServeMux()
 	s.register_rpc_root(mux, s.Provider)
+	if prefix := strings.TrimSuffix(s.options.Prefix, "/"); prefix != "" {
+		return http.StripPrefix(prefix, mux)
+	}
 	return mux
 }
 
@@ -75,41 +285,42 @@ func (s *Server) register_rpc_root(
 		req = req.WithContext(ctx)
 
 		var arg0 string
-		args := [1]interface{}{
+		args := []interface{}{
 			&arg0,
 		}
 
-		if req.Body != nil {
-			if err := json.NewDecoder(req.Body).Decode(&args); err != nil {
-				renderResult(s.options, resp, 400, &Result{
-					Error:   err,
-					Returns: nil,
-				})
-				return
-			}
+		if status, err := s.decodeArgs(resp, req, args); err != nil {
+			renderResult(s.options, resp, status, &Result{
+				Error:   err,
+				Returns: nil,
+			})
+			return
 		}
 
-		var (
-			out0 *rpc_root.TodoItem
-		)
+		info := &MethodInfo{
+			Namespace:   "api",
+			Name:        "Create",
+			Args:        []interface{}{arg0},
+			Annotations: Annotations{},
+		}
 
-		out0, err = handler.Create(
-			ctx, arg0)
+		returns, err := s.invoke(ctx, info, func(ctx context.Context, _ *MethodInfo) ([]interface{}, error) {
+			out0, err := handler.Create(ctx, arg0)
+			return []interface{}{out0}, err
+		})
 
-		result := &Result{}
+		status, result := http.StatusOK, &Result{}
 		if err != nil {
 			err = s.options.ErrFilter(req, "api/Create", err)
 			if s.options.ErrLog != nil {
 				s.options.ErrLog(req, "api/Create", err)
 			}
-			result.Error = err
+			status, result.Error = s.options.StatusFor(err), err
 		} else {
-			result.Returns = []interface{}{
-				out0,
-			}
+			result.Returns = returns
 		}
 
-		renderResult(s.options, resp, 200, result)
+		renderResult(s.options, resp, status, result)
 	})
 
 	mux.HandleFunc("/api/Destroy", func(resp http.ResponseWriter, req *http.Request) {
@@ -122,41 +333,42 @@ func (s *Server) register_rpc_root(
 		req = req.WithContext(ctx)
 
 		var arg0 int64
-		args := [1]interface{}{
+		args := []interface{}{
 			&arg0,
 		}
 
-		if req.Body != nil {
-			if err := json.NewDecoder(req.Body).Decode(&args); err != nil {
-				renderResult(s.options, resp, 400, &Result{
-					Error:   err,
-					Returns: nil,
-				})
-				return
-			}
+		if status, err := s.decodeArgs(resp, req, args); err != nil {
+			renderResult(s.options, resp, status, &Result{
+				Error:   err,
+				Returns: nil,
+			})
+			return
 		}
 
-		var (
-			out0 *rpc_root.TodoItem
-		)
+		info := &MethodInfo{
+			Namespace:   "api",
+			Name:        "Destroy",
+			Args:        []interface{}{arg0},
+			Annotations: Annotations{},
+		}
 
-		out0, err = handler.Destroy(
-			ctx, arg0)
+		returns, err := s.invoke(ctx, info, func(ctx context.Context, _ *MethodInfo) ([]interface{}, error) {
+			out0, err := handler.Destroy(ctx, arg0)
+			return []interface{}{out0}, err
+		})
 
-		result := &Result{}
+		status, result := http.StatusOK, &Result{}
 		if err != nil {
 			err = s.options.ErrFilter(req, "api/Destroy", err)
 			if s.options.ErrLog != nil {
 				s.options.ErrLog(req, "api/Destroy", err)
 			}
-			result.Error = err
+			status, result.Error = s.options.StatusFor(err), err
 		} else {
-			result.Returns = []interface{}{
-				out0,
-			}
+			result.Returns = returns
 		}
 
-		renderResult(s.options, resp, 200, result)
+		renderResult(s.options, resp, status, result)
 	})
 
 	mux.HandleFunc("/api/List", func(resp http.ResponseWriter, req *http.Request) {
@@ -168,27 +380,40 @@ func (s *Server) register_rpc_root(
 		ctx = s.options.CtxFilter(req, "api/List")
 		req = req.WithContext(ctx)
 
-		var (
-			out0 []*rpc_root.TodoItem
-		)
+		args := []interface{}{}
 
-		out0, err = handler.List(
-			ctx)
+		if status, err := s.decodeArgs(resp, req, args); err != nil {
+			renderResult(s.options, resp, status, &Result{
+				Error:   err,
+				Returns: nil,
+			})
+			return
+		}
+
+		info := &MethodInfo{
+			Namespace:   "api",
+			Name:        "List",
+			Args:        []interface{}{},
+			Annotations: Annotations{},
+		}
 
-		result := &Result{}
+		returns, err := s.invoke(ctx, info, func(ctx context.Context, _ *MethodInfo) ([]interface{}, error) {
+			out0, err := handler.List(ctx)
+			return []interface{}{out0}, err
+		})
+
+		status, result := http.StatusOK, &Result{}
 		if err != nil {
 			err = s.options.ErrFilter(req, "api/List", err)
 			if s.options.ErrLog != nil {
 				s.options.ErrLog(req, "api/List", err)
 			}
-			result.Error = err
+			status, result.Error = s.options.StatusFor(err), err
 		} else {
-			result.Returns = []interface{}{
-				out0,
-			}
+			result.Returns = returns
 		}
 
-		renderResult(s.options, resp, 200, result)
+		renderResult(s.options, resp, status, result)
 	})
 
 	mux.HandleFunc("/api/UpdateState", func(resp http.ResponseWriter, req *http.Request) {
@@ -202,64 +427,138 @@ func (s *Server) register_rpc_root(
 
 		var arg0 int64
 		var arg1 rpc_root.State
-		args := [2]interface{}{
+		args := []interface{}{
 			&arg0,
 			&arg1,
 		}
 
-		if req.Body != nil {
-			if err := json.NewDecoder(req.Body).Decode(&args); err != nil {
-				renderResult(s.options, resp, 400, &Result{
-					Error:   err,
-					Returns: nil,
-				})
-				return
-			}
+		if status, err := s.decodeArgs(resp, req, args); err != nil {
+			renderResult(s.options, resp, status, &Result{
+				Error:   err,
+				Returns: nil,
+			})
+			return
 		}
 
-		var (
-			out0 *rpc_root.TodoItem
-		)
+		info := &MethodInfo{
+			Namespace:   "api",
+			Name:        "UpdateState",
+			Args:        []interface{}{arg0, arg1},
+			Annotations: Annotations{},
+		}
 
-		out0, err = handler.UpdateState(
-			ctx, arg0, arg1)
+		returns, err := s.invoke(ctx, info, func(ctx context.Context, _ *MethodInfo) ([]interface{}, error) {
+			out0, err := handler.UpdateState(ctx, arg0, arg1)
+			return []interface{}{out0}, err
+		})
 
-		result := &Result{}
+		status, result := http.StatusOK, &Result{}
 		if err != nil {
 			err = s.options.ErrFilter(req, "api/UpdateState", err)
 			if s.options.ErrLog != nil {
 				s.options.ErrLog(req, "api/UpdateState", err)
 			}
-			result.Error = err
+			status, result.Error = s.options.StatusFor(err), err
 		} else {
-			result.Returns = []interface{}{
-				out0,
-			}
+			result.Returns = returns
 		}
 
-		renderResult(s.options, resp, 200, result)
+		renderResult(s.options, resp, status, result)
 	})
 
 	return mux
 }
 
+// decodeArgs checks the request and decodes the rpc arguments from its body into args,
+// returning the status to respond with when they can't be.
+func (s *Server) decodeArgs(resp http.ResponseWriter, req *http.Request, args []interface{}) (int, error) {
+	if !s.options.Lenient {
+		if req.Method != http.MethodPost {
+			resp.Header().Set("Allow", http.MethodPost)
+			return http.StatusMethodNotAllowed, &Error{
+				Code:    CodeInvalidArgument,
+				Message: fmt.Sprintf("method %s is not allowed, use POST", req.Method),
+			}
+		}
+
+		contentType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		if contentType != "application/json" {
+			return http.StatusUnsupportedMediaType, &Error{
+				Code:    CodeInvalidArgument,
+				Message: fmt.Sprintf("content type %q is not supported, use application/json", contentType),
+			}
+		}
+	}
+
+	var body io.Reader = req.Body
+	if body == nil {
+		body = &bytes.Buffer{}
+	} else if max := s.options.MaxBodyBytes; max > 0 {
+		buf, err := ioutil.ReadAll(io.LimitReader(req.Body, max+1))
+		if err != nil {
+			return http.StatusBadRequest, &Error{Code: CodeInvalidArgument, Message: err.Error()}
+		} else if int64(len(buf)) > max {
+			return http.StatusRequestEntityTooLarge, &Error{
+				Code:    CodeInvalidArgument,
+				Message: fmt.Sprintf("request body is larger than %d bytes", max),
+			}
+		}
+		body = bytes.NewReader(buf)
+	}
+
+	// an empty body is the same as an empty argument list
+	var raws []json.RawMessage
+	if err := json.NewDecoder(body).Decode(&raws); err != nil && err != io.EOF {
+		return http.StatusBadRequest, &Error{Code: CodeInvalidArgument, Message: err.Error()}
+	} else if len(raws) != len(args) {
+		return http.StatusBadRequest, &Error{
+			Code:    CodeInvalidArgument,
+			Message: fmt.Sprintf("expected %d arguments, got %d", len(args), len(raws)),
+		}
+	}
+
+	for idx, raw := range raws {
+		if err := json.Unmarshal(raw, args[idx]); err != nil {
+			return http.StatusBadRequest, &Error{
+				Code:    CodeInvalidArgument,
+				Message: fmt.Sprintf("argument %d: %s", idx, err),
+			}
+		}
+	}
+	return http.StatusOK, nil
+}
+
 func renderResult(options Options, resp http.ResponseWriter, status int, result *Result) {
 	resp.Header().Set("Content-Type", "application/json")
 
 	shim := struct {
-		Error   *string       `json:"error"`
+		Error   *Error        `json:"error"`
 		Returns []interface{} `json:"returns"`
 	}{}
 
 	if result.Error != nil {
-		var errstr string
-		if options.FormatErr != nil {
-			errstr = options.FormatErr(result.Error)
+		var (
+			panicked *PanicError
+			known    *Error
+			coded    interface{ ErrorCode() string }
+		)
+
+		wireErr := &Error{Code: CodeInternal}
+		if errors.As(result.Error, &panicked) {
+			wireErr.Message = "internal error"
+		} else if options.FormatErr != nil {
+			wireErr.Message = options.FormatErr(result.Error)
 		} else {
-			errstr = result.Error.Error()
+			wireErr.Message = result.Error.Error()
+		}
+
+		if errors.As(result.Error, &known) {
+			wireErr.Code, wireErr.Details = known.Code, known.Details
+		} else if errors.As(result.Error, &coded) {
+			wireErr.Code, wireErr.Details = coded.ErrorCode(), coded
 		}
 
-		shim.Returns, shim.Error = nil, &errstr
+		shim.Returns, shim.Error = nil, wireErr
 
 	} else {
 		shim.Returns, shim.Error = result.Returns, nil
@@ -268,7 +567,7 @@ func renderResult(options Options, resp http.ResponseWriter, status int, result
 	buf, err := json.Marshal(shim)
 	if err != nil {
 		resp.WriteHeader(500)
-		_, _ = resp.Write([]byte(`{"error":"json processing error","result":null}`))
+		_, _ = resp.Write([]byte(`{"error":{"code":"internal","message":"json processing error"},"returns":null}`))
 	} else {
 		resp.WriteHeader(status)
 		_, _ = resp.Write(buf)
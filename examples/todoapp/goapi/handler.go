@@ -2,11 +2,11 @@ package main
 
 import (
 	"context"
-	"errors"
 	"sync/atomic"
 	"time"
 
 	"github.com/chakrit/rpc/todo/api"
+	"github.com/chakrit/rpc/todo/api/server"
 )
 
 type handler struct {
@@ -14,7 +14,7 @@ type handler struct {
 	items   []*api.TodoItem
 }
 
-var errNotFound = errors.New("item not found")
+var errNotFound = server.Errorf(server.CodeNotFound, "item not found")
 
 var _ api.Interface = &handler{}
 
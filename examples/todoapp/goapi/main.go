@@ -55,10 +55,14 @@ func main() {
 func runServerCmd(cmd *cobra.Command, args []string) {
 	opts := server.Options{
 		Addr:      flags.Addr,
+		Prefix:    "",
 		CtxFilter: nil,
 		ErrFilter: nil,
 		ErrLog:    nil,
 		FormatErr: nil,
+		StatusFor: nil,
+
+		Interceptors: nil,
 	}
 	srv := server.New(&opts)
 	srv.Provider = provider{}
@@ -75,7 +79,7 @@ func runServerCmd(cmd *cobra.Command, args []string) {
 
 func runClientCmd(cmd *cobra.Command, args []string) {
 	var (
-		opts = client.Options(flags)
+		opts = client.Options{Addr: flags.Addr}
 		c    = client.New(&opts)
 		ctx  = context.Background()
 
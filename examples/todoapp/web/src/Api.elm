@@ -11,11 +11,14 @@ import Task exposing (Task)
 import Time exposing (Posix)
 import Bytes exposing (Bytes)
 import Bytes.Encode
-import RpcUtil exposing (Config, RpcError, RpcResult, decodeApply, fromHttpResult)
+import RpcUtil exposing (Config, RpcError, RpcResult, decodeApply)
 
 
 
+{-| TodoItem is a single entry in the todo list.
+-}
 type alias TodoItem =
+    -- creation time of the item
     { ctime : Posix
     , description : String
     , id : Int
@@ -74,9 +77,12 @@ decodeTodoItem =
 
 
 
+{-| State tracks the progress of a TodoItem.
+-}
 type State
     = New
     | InProgress
+    -- Overdue items are past their due date and not yet completed.
     | Overdue
     | Completed
 
@@ -162,9 +168,9 @@ type alias InputForCreate =
 
 encodeInputForCreate : InputForCreate -> E.Value
 encodeInputForCreate
-    (arg0) =
+    (description) =
         E.list (identity)
-            [ E.string arg0
+            [ E.string description
             ]
 
 decodeInputForCreate : D.Decoder InputForCreate
@@ -198,9 +204,9 @@ type alias InputForDestroy =
 
 encodeInputForDestroy : InputForDestroy -> E.Value
 encodeInputForDestroy
-    (arg0) =
+    (id) =
         E.list (identity)
-            [ E.int arg0
+            [ E.int id
             ]
 
 decodeInputForDestroy : D.Decoder InputForDestroy
@@ -266,10 +272,10 @@ type alias InputForUpdateState =
 
 encodeInputForUpdateState : InputForUpdateState -> E.Value
 encodeInputForUpdateState
-    (arg0,arg1) =
+    (id,state) =
         E.list (identity)
-            [ E.int arg0
-            , encodeState arg1
+            [ E.int id
+            , encodeState state
             ]
 
 decodeInputForUpdateState : D.Decoder InputForUpdateState
@@ -308,10 +314,10 @@ decodeOutputForUpdateState =
 
 
 callCreateTask : Config -> InputForCreate -> Task RpcError OutputForCreate
-callCreateTask config input =
+callCreateTask config ( description ) =
     let
         body =
-            Http.jsonBody (encodeInputForCreate input)
+            Http.jsonBody (encodeInputForCreate ( description ))
 
         resolver =
             RpcUtil.resolver decodeOutputForCreate
@@ -327,10 +333,10 @@ callCreateTask config input =
 
 
 callCreate : Config -> InputForCreate -> (RpcResult OutputForCreate -> a) -> Cmd a
-callCreate config input mapResult =
+callCreate config ( description ) mapResult =
     let
-        body = Http.jsonBody (encodeInputForCreate input)
-        expect = Http.expectJson (fromHttpResult >> mapResult) (RpcUtil.decoder decodeOutputForCreate)
+        body = Http.jsonBody (encodeInputForCreate ( description ))
+        expect = RpcUtil.expect mapResult decodeOutputForCreate
     in
     Http.request
         { method = "POST"
@@ -343,10 +349,10 @@ callCreate config input mapResult =
         }
 
 callDestroyTask : Config -> InputForDestroy -> Task RpcError OutputForDestroy
-callDestroyTask config input =
+callDestroyTask config ( id ) =
     let
         body =
-            Http.jsonBody (encodeInputForDestroy input)
+            Http.jsonBody (encodeInputForDestroy ( id ))
 
         resolver =
             RpcUtil.resolver decodeOutputForDestroy
@@ -362,10 +368,10 @@ callDestroyTask config input =
 
 
 callDestroy : Config -> InputForDestroy -> (RpcResult OutputForDestroy -> a) -> Cmd a
-callDestroy config input mapResult =
+callDestroy config ( id ) mapResult =
     let
-        body = Http.jsonBody (encodeInputForDestroy input)
-        expect = Http.expectJson (fromHttpResult >> mapResult) (RpcUtil.decoder decodeOutputForDestroy)
+        body = Http.jsonBody (encodeInputForDestroy ( id ))
+        expect = RpcUtil.expect mapResult decodeOutputForDestroy
     in
     Http.request
         { method = "POST"
@@ -377,11 +383,13 @@ callDestroy config input mapResult =
         , tracker = Nothing
         }
 
+{-| List returns all todo items.
+-}
 callListTask : Config -> InputForList -> Task RpcError OutputForList
-callListTask config input =
+callListTask config () =
     let
         body =
-            Http.jsonBody (encodeInputForList input)
+            Http.jsonBody (encodeInputForList ())
 
         resolver =
             RpcUtil.resolver decodeOutputForList
@@ -396,11 +404,13 @@ callListTask config input =
         }
 
 
+{-| List returns all todo items.
+-}
 callList : Config -> InputForList -> (RpcResult OutputForList -> a) -> Cmd a
-callList config input mapResult =
+callList config () mapResult =
     let
-        body = Http.jsonBody (encodeInputForList input)
-        expect = Http.expectJson (fromHttpResult >> mapResult) (RpcUtil.decoder decodeOutputForList)
+        body = Http.jsonBody (encodeInputForList ())
+        expect = RpcUtil.expect mapResult decodeOutputForList
     in
     Http.request
         { method = "POST"
@@ -413,10 +423,10 @@ callList config input mapResult =
         }
 
 callUpdateStateTask : Config -> InputForUpdateState -> Task RpcError OutputForUpdateState
-callUpdateStateTask config input =
+callUpdateStateTask config ( id, state ) =
     let
         body =
-            Http.jsonBody (encodeInputForUpdateState input)
+            Http.jsonBody (encodeInputForUpdateState ( id, state ))
 
         resolver =
             RpcUtil.resolver decodeOutputForUpdateState
@@ -432,10 +442,10 @@ callUpdateStateTask config input =
 
 
 callUpdateState : Config -> InputForUpdateState -> (RpcResult OutputForUpdateState -> a) -> Cmd a
-callUpdateState config input mapResult =
+callUpdateState config ( id, state ) mapResult =
     let
-        body = Http.jsonBody (encodeInputForUpdateState input)
-        expect = Http.expectJson (fromHttpResult >> mapResult) (RpcUtil.decoder decodeOutputForUpdateState)
+        body = Http.jsonBody (encodeInputForUpdateState ( id, state ))
+        expect = RpcUtil.expect mapResult decodeOutputForUpdateState
     in
     Http.request
         { method = "POST"
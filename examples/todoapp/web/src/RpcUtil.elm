@@ -1,5 +1,6 @@
 module RpcUtil exposing
     ( Config
+    , ErrorInfo
     , RpcError(..)
     , RpcResult
     , configDecoder
@@ -8,7 +9,9 @@ module RpcUtil exposing
     , decodeValue
     , decoder
     , errorToString
+    , expect
     , fromHttpResult
+    , fromResponse
     , map
     , resolver
     )
@@ -24,6 +27,7 @@ import Bytes.Encode as BytesEnc
 import Dict exposing (Dict)
 import Http exposing (Error(..), Resolver, Response(..))
 import Json.Decode as JsonDec
+import Json.Encode as JsonEnc
 
 
 type alias Config =
@@ -60,10 +64,20 @@ decodeApply fieldDec partial =
     JsonDec.andThen (\p -> JsonDec.map p fieldDec) partial
 
 
+{-| ErrorInfo is an error reported by the server. The code identifies errors declared
+with `error` in the spec and details holds its properties, or `null` for other errors.
+-}
+type alias ErrorInfo =
+    { code : String
+    , message : String
+    , details : JsonDec.Value
+    }
+
+
 type RpcError
     = HttpError Http.Error
     | JsonError JsonDec.Error
-    | ApiError String
+    | ApiError ErrorInfo
 
 
 type alias RpcResult a =
@@ -102,30 +116,46 @@ errorToString httpErr =
         JsonError err ->
             "JSON Error: " ++ JsonDec.errorToString err
 
-        ApiError str ->
-            str
+        ApiError info ->
+            info.message
 
 
-resolver : JsonDec.Decoder a -> Resolver RpcError a
-resolver decoder_ =
-    Http.stringResolver
-        (\resp ->
-            case resp of
-                BadUrl_ s ->
-                    Err (HttpError (BadUrl s))
+{-| fromResponse decodes a response from the server. Errors are sent with a non-2xx
+status, so bad statuses are decoded as an ApiError when the body has one and left as an
+HttpError otherwise.
+-}
+fromResponse : JsonDec.Decoder a -> Response String -> RpcResult a
+fromResponse decoder_ resp =
+    case resp of
+        BadUrl_ s ->
+            Err (HttpError (BadUrl s))
+
+        Timeout_ ->
+            Err (HttpError Timeout)
 
-                Timeout_ ->
-                    Err (HttpError Timeout)
+        NetworkError_ ->
+            Err (HttpError NetworkError)
 
-                NetworkError_ ->
-                    Err (HttpError NetworkError)
+        BadStatus_ metadata str ->
+            case JsonDec.decodeString (JsonDec.field "error" errorInfoDecoder) str of
+                Ok info ->
+                    Err (ApiError info)
 
-                BadStatus_ metadata _ ->
+                Err _ ->
                     Err (HttpError (BadStatus metadata.statusCode))
 
-                GoodStatus_ metadata str ->
-                    decodeString (decoder decoder_) str
-        )
+        GoodStatus_ _ str ->
+            decodeString (decoder decoder_) str
+
+
+resolver : JsonDec.Decoder a -> Resolver RpcError a
+resolver decoder_ =
+    Http.stringResolver (fromResponse decoder_)
+
+
+expect : (RpcResult a -> msg) -> JsonDec.Decoder a -> Http.Expect msg
+expect toMsg decoder_ =
+    Http.expectStringResponse toMsg (fromResponse decoder_)
 
 
 map : (RpcError -> msg) -> (a -> msg) -> RpcResult a -> msg
@@ -163,16 +193,26 @@ decodeValue decoder_ value =
 decoder : JsonDec.Decoder a -> JsonDec.Decoder (RpcResult a)
 decoder returnDecoder =
     let
-        mapToResult : Maybe String -> a -> RpcResult a
+        mapToResult : Maybe ErrorInfo -> a -> RpcResult a
         mapToResult err ret =
             case err of
-                Just str ->
-                    Err (ApiError str)
+                Just info ->
+                    Err (ApiError info)
 
                 Nothing ->
                     Ok ret
     in
     JsonDec.map2 mapToResult
-        (JsonDec.field "error" (JsonDec.maybe JsonDec.string))
+        (JsonDec.field "error" (JsonDec.nullable errorInfoDecoder))
         (JsonDec.field "returns" returnDecoder)
 
+
+errorInfoDecoder : JsonDec.Decoder ErrorInfo
+errorInfoDecoder =
+    JsonDec.map3 ErrorInfo
+        (JsonDec.field "code" JsonDec.string)
+        (JsonDec.field "message" JsonDec.string)
+        (JsonDec.maybe (JsonDec.field "details" JsonDec.value)
+            |> JsonDec.map (Maybe.withDefault JsonEnc.null)
+        )
+
@@ -18,26 +18,38 @@ const (
 
 type (
 	Field struct {
-		Name string
-		Type *TypeRef
+		Name     string
+		JSONName string
+		Doc      string
+		Type     *TypeRef
+
+		Annotations spec.Annotations
 	}
 
 	Member struct {
 		Name  string
+		Doc   string
 		Value string
 		Title string
 	}
 
 	Type struct {
 		Name   string
+		Doc    string
 		Fields []*Field
 		Module *Module
+
+		Annotations spec.Annotations
 	}
 
 	Enum struct {
 		Name    string
+		Doc     string
 		Members []*Member
+		Integer bool
 		Module  *Module
+
+		Annotations spec.Annotations
 	}
 
 	TypeRef struct {
@@ -54,39 +66,53 @@ type (
 	}
 
 	Tuple struct {
-		Name string
-		Args []*TypeRef
+		Name  string
+		Args  []*TypeRef
+		Names []string
+	}
+
+	// Thrown is an error an rpc declares with `throws`, decoded into a variant of the
+	// rpc's ErrorFor union type.
+	Thrown struct {
+		Constructor string
+		Code        string
+		Type        *TypeRef
 	}
 
 	RpcFunc struct {
 		Name    string
+		Doc     string
 		RPCPath string
 
 		InArgs  []*TypeRef
+		InNames []string
 		OutArgs []*TypeRef
+		Errors  []*Thrown
+
+		Annotations spec.Annotations
 	}
 )
 
-func Generate(ns *spec.Namespace, outdir string) error {
+func Generate(ns *spec.Namespace, outdir string, templates tmpldata.Overlay) error {
 	module := newModule(nil, outdir, ns)
 	utilModule := newUtilModule(module, outdir, ns)
 
-	if err := writeModule(utilModule, UtilTemplateName); err != nil {
+	if err := writeModule(templates, utilModule, UtilTemplateName); err != nil {
 		return err
 	}
-	if err := writeModule(module, RpcTemplateName); err != nil {
+	if err := writeModule(templates, module, RpcTemplateName); err != nil {
 		return err
 	}
 	return nil
 }
 
-func writeModule(mod *Module, templateName string) error {
-	if err := writeTmpl(mod.OutPath, templateName, mod); err != nil {
+func writeModule(templates tmpldata.Overlay, mod *Module, templateName string) error {
+	if err := writeTmpl(templates, mod.OutPath, templateName, mod); err != nil {
 		return fmt.Errorf("elm template failure: %w", err)
 	}
 
 	for _, child := range mod.Children {
-		if err := writeModule(child, templateName); err != nil {
+		if err := writeModule(templates, child, templateName); err != nil {
 			return err
 		}
 	}
@@ -94,7 +120,7 @@ func writeModule(mod *Module, templateName string) error {
 	return nil
 }
 
-func writeTmpl(outpath, tmplname string, mod *Module) error {
+func writeTmpl(templates tmpldata.Overlay, outpath, tmplname string, mod *Module) error {
 	if err := os.MkdirAll(filepath.Dir(outpath), 0755); err != nil {
 		return err
 	}
@@ -105,7 +131,7 @@ func writeTmpl(outpath, tmplname string, mod *Module) error {
 	}
 	defer outfile.Close()
 
-	tmplContent, err := tmpldata.Read(tmplname)
+	tmplContent, err := templates.Read(tmplname)
 	if err != nil {
 		return err
 	}
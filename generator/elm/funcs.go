@@ -1,7 +1,10 @@
 package elm
 
 import (
+	"strings"
 	"text/template"
+
+	"github.com/chakrit/rpc/spec"
 )
 
 func funcMap() template.FuncMap {
@@ -18,5 +21,56 @@ func funcMap() template.FuncMap {
 		return ref.Module.Registry.Resolve(ref)
 	}
 
+	f["docBlock"] = docBlock
+	f["comment"] = comment
 	return f
 }
+
+// docBlock renders a doc comment from the spec as an Elm `{-| -}` block, terminated by
+// a newline so it can be placed right before a top-level declaration. A `@deprecated`
+// annotation is noted at the end of the block.
+func docBlock(doc string, annotations ...spec.Annotations) string {
+	doc = withDeprecation(doc, annotations)
+	if doc == "" {
+		return ""
+	}
+
+	lines := strings.Split(strings.ReplaceAll(doc, "-}", "- }"), "\n")
+	for idx := 1; idx < len(lines); idx++ {
+		if lines[idx] != "" {
+			lines[idx] = "    " + lines[idx]
+		}
+	}
+	return "{-| " + strings.Join(lines, "\n") + "\n-}\n"
+}
+
+// comment renders a doc comment as `--` line comments at the given indentation, for
+// places where Elm does not allow doc blocks such as record fields and union members.
+func comment(indent, doc string, annotations ...spec.Annotations) string {
+	doc = withDeprecation(doc, annotations)
+	if doc == "" {
+		return ""
+	}
+
+	sb := &strings.Builder{}
+	for _, line := range strings.Split(doc, "\n") {
+		sb.WriteString(strings.TrimRight("-- "+line, " ") + "\n" + indent)
+	}
+	return sb.String()
+}
+
+func withDeprecation(doc string, annotations []spec.Annotations) string {
+	for _, list := range annotations {
+		if deprecated := list.Lookup("deprecated"); deprecated != nil {
+			if doc != "" {
+				doc += "\n\n"
+			}
+			message := deprecated.Arg(0)
+			if message == "" {
+				message = "this is no longer supported."
+			}
+			doc += "**Deprecated:** " + message
+		}
+	}
+	return doc
+}
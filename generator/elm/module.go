@@ -5,12 +5,24 @@ import (
 	"path"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/chakrit/rpc/internal"
 	"github.com/chakrit/rpc/spec"
 )
 
+// names that can't be used as-is for generated arguments, either Elm keywords or names
+// already used by the templates. Elm does not allow shadowing.
+var reservedNames = map[string]struct{}{
+	"if": {}, "then": {}, "else": {}, "case": {}, "of": {}, "let": {}, "in": {},
+	"type": {}, "alias": {}, "module": {}, "import": {}, "exposing": {}, "as": {},
+	"port": {}, "where": {}, "infix": {}, "effect": {},
+
+	"config": {}, "input": {}, "mapResult": {}, "body": {}, "expect": {},
+	"resolver": {}, "obj": {}, "decodeApply": {}, "fromHttpResult": {},
+}
+
 type Module struct {
 	Name    string
 	RPCPath string
@@ -80,34 +92,31 @@ func newModule(parent *Module, outdir string, ns *spec.Namespace) *Module {
 func (m *Module) resolveTypes() {
 	for _, t := range m.Namespace.Types.SortedByName() {
 		typ := t.(*spec.Type)
-		elmType := &Type{
-			Name:   typ.Name,
-			Module: m,
-		}
-
-		for _, p := range typ.Properties.SortedByName() {
-			prop := p.(*spec.Property)
-			elmType.Fields = append(elmType.Fields, &Field{
-				Name: prop.Name,
-				Type: m.mapTypeRef(prop.Type),
-			})
-		}
+		m.addType(typ.Name, typ.Doc, typ.Properties, typ.Annotations)
+	}
 
-		m.Types = append(m.Types, elmType)
-		m.Registry.RegisterType(elmType)
+	// errors are plain records on the Elm side, decoded from the error details
+	for _, e := range m.Namespace.Errors.SortedByName() {
+		err := e.(*spec.Error)
+		m.addType(err.Name, err.Doc, err.Properties, err.Annotations)
 	}
 
 	for _, e := range m.Namespace.Enums.SortedByName() {
 		enum := e.(*spec.Enum)
 		elmEnum := &Enum{
-			Name:   enum.Name,
-			Module: m,
+			Name:    enum.Name,
+			Doc:     enum.Doc,
+			Integer: enum.Integer,
+			Module:  m,
+
+			Annotations: enum.Annotations,
 		}
 
 		for _, m := range enum.Members {
 			elmEnum.Members = append(elmEnum.Members, &Member{
 				Name:  m,
-				Value: internal.InflectDash(m),
+				Doc:   enum.MemberDocs[m],
+				Value: enum.Value(m),
 				Title: internal.InflectTitle(m),
 			})
 		}
@@ -117,6 +126,36 @@ func (m *Module) resolveTypes() {
 	}
 }
 
+func (m *Module) addType(name, doc string, props spec.Mappings, annotations spec.Annotations) {
+	elmType := &Type{
+		Name:   name,
+		Doc:    doc,
+		Module: m,
+
+		Annotations: annotations,
+	}
+
+	for _, p := range props.SortedByName() {
+		prop := p.(*spec.Property)
+		jsonName := prop.Name
+		if name := prop.Annotations.Lookup("json").Param("name"); name != "" {
+			jsonName = name
+		}
+
+		elmType.Fields = append(elmType.Fields, &Field{
+			Name:     prop.Name,
+			JSONName: jsonName,
+			Doc:      prop.Doc,
+			Type:     m.mapTypeRef(prop.Type),
+
+			Annotations: prop.Annotations,
+		})
+	}
+
+	m.Types = append(m.Types, elmType)
+	m.Registry.RegisterType(elmType)
+}
+
 func (m *Module) resolveRPCFuncs() {
 	for _, r := range m.Namespace.RPCs.SortedByName() {
 		var (
@@ -125,19 +164,35 @@ func (m *Module) resolveRPCFuncs() {
 			outTup = &Tuple{Name: "OutputFor" + rpc.Name}
 		)
 
-		for _, ref := range rpc.InputTypes {
+		for idx, ref := range rpc.InputTypes {
 			inTup.Args = append(inTup.Args, m.mapTypeRef(ref))
+			inTup.Names = append(inTup.Names, argName(rpc, idx))
 		}
-		for _, ref := range rpc.OutputTypes {
+		for idx, ref := range rpc.OutputTypes {
 			outTup.Args = append(outTup.Args, m.mapTypeRef(ref))
+			outTup.Names = append(outTup.Names, "arg"+strconv.Itoa(idx))
+		}
+
+		var errors []*Thrown
+		for _, ref := range rpc.Errors {
+			errors = append(errors, &Thrown{
+				Constructor: rpc.Name + ref.Name,
+				Code:        internal.InflectSnake(ref.Name),
+				Type:        m.mapTypeRef(ref),
+			})
 		}
 
 		m.Tuples = append(m.Tuples, inTup, outTup)
 		m.RPCFuncs = append(m.RPCFuncs, &RpcFunc{
 			Name:    rpc.Name,
+			Doc:     rpc.Doc,
 			RPCPath: path.Join(m.RPCPath, rpc.Name),
 			InArgs:  inTup.Args,
+			InNames: inTup.Names,
 			OutArgs: outTup.Args,
+			Errors:  errors,
+
+			Annotations: rpc.Annotations,
 		})
 	}
 }
@@ -182,6 +237,11 @@ func (m *Module) resolveImports() {
 			check(arg)
 		}
 	}
+	for _, rpc := range m.RPCFuncs {
+		for _, thrown := range rpc.Errors {
+			check(thrown.Type)
+		}
+	}
 
 	sort.Slice(m.Imports, func(i, j int) bool {
 		mi, mj := m.Imports[i], m.Imports[j]
@@ -189,6 +249,20 @@ func (m *Module) resolveImports() {
 	})
 }
 
+// argName returns an Elm variable name for the rpc input argument at the given index,
+// falling back to positional names for unnamed arguments.
+func argName(rpc *spec.RPC, index int) string {
+	if index >= len(rpc.InputNames) || rpc.InputNames[index] == "" {
+		return "arg" + strconv.Itoa(index)
+	}
+
+	name := internal.InflectCamel(rpc.InputNames[index])
+	if _, reserved := reservedNames[name]; reserved {
+		name += "_"
+	}
+	return name
+}
+
 func (m *Module) mapTypeRef(ref *spec.TypeRef) *TypeRef {
 	elmRef := &TypeRef{
 		Name:   ref.Name,
@@ -47,6 +47,8 @@ func (r Registry) Resolve(ref *TypeRef) *TypeResolution {
 		return r.resolveList(ref)
 	case "map":
 		return r.resolveMap(ref)
+	case "optional":
+		return r.resolveOptional(ref)
 	default:
 		return r.resolveUserDefined(ref)
 	}
@@ -147,6 +149,22 @@ func (r Registry) resolveMap(ref *TypeRef) *TypeResolution {
 	}
 }
 
+func (r Registry) resolveOptional(ref *TypeRef) *TypeResolution {
+	var innerType *TypeResolution
+	if len(ref.Args) > 0 {
+		innerType = r.Resolve(ref.Args[0])
+	} else {
+		innerType = r.resolveUnknown()
+	}
+
+	return &TypeResolution{
+		Name:    "Maybe (" + innerType.Name + ")",
+		Encode:  "(Maybe.map (" + innerType.Encode + ") >> Maybe.withDefault E.null)",
+		Decode:  "D.nullable (" + innerType.Decode + ")",
+		Default: "Nothing",
+	}
+}
+
 func (r Registry) resolveUserDefined(ref *TypeRef) *TypeResolution {
 	entry := r.Lookup(ref.Module, ref.Name)
 	if entry == nil {
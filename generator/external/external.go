@@ -0,0 +1,105 @@
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/chakrit/rpc/spec"
+)
+
+// TargetPrefix marks targets that run an external generator, such as `exec:rpc-gen-rust`.
+// The rest of the target is the executable, looked up in PATH unless it contains a slash.
+const TargetPrefix = "exec:"
+
+type (
+	// Response is what external generators print to stdout, as JSON, after reading the
+	// spec from stdin in the same form as `-parse` prints it.
+	Response struct {
+		Files []*File `json:"files"`
+	}
+
+	// File is a file to write, with its path relative to the output folder using forward
+	// slashes, such as `src/lib.rs`.
+	File struct {
+		Path    string `json:"path"`
+		Content string `json:"content"`
+	}
+)
+
+// Generator returns a generator that runs the given executable.
+func Generator(command string) func(ns *spec.Namespace, outdir string) error {
+	return func(ns *spec.Namespace, outdir string) error {
+		return Generate(command, ns, outdir)
+	}
+}
+
+// Generate runs the executable with the spec on stdin and writes the files it responds
+// with under outdir. Anything it prints to stderr is passed through, and nothing is
+// written unless it exits successfully with a valid response.
+func Generate(command string, ns *spec.Namespace, outdir string) error {
+	if command == "" {
+		return errors.New("no executable given after `" + TargetPrefix + "`")
+	}
+
+	input := &bytes.Buffer{}
+	encoder := json.NewEncoder(input)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(ns); err != nil {
+		return fmt.Errorf("json encode failure: %w", err)
+	}
+
+	output := &bytes.Buffer{}
+	cmd := exec.Command(command)
+	cmd.Stdin = input
+	cmd.Stdout = output
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s failure: %w", command, err)
+	}
+
+	resp := &Response{}
+	if err := json.Unmarshal(output.Bytes(), resp); err != nil {
+		return fmt.Errorf("%s response failure: %w", command, err)
+	}
+
+	// check every path before writing anything, so bad responses leave outdir untouched
+	for _, file := range resp.Files {
+		if err := checkPath(file.Path); err != nil {
+			return fmt.Errorf("%s response failure: %w", command, err)
+		}
+	}
+
+	for _, file := range resp.Files {
+		outpath := filepath.Join(outdir, filepath.FromSlash(file.Path))
+		if err := os.MkdirAll(filepath.Dir(outpath), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(outpath, []byte(file.Content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkPath(path string) error {
+	cleaned := filepath.Clean(filepath.FromSlash(path))
+	switch {
+	case path == "":
+		return errors.New("file without a path")
+	case filepath.IsAbs(cleaned) || strings.HasPrefix(path, "/"):
+		return errors.New("absolute file path `" + path + "`")
+	case cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)):
+		return errors.New("file path `" + path + "` is outside the output folder")
+	case cleaned == ".":
+		return errors.New("file path `" + path + "` is not a file")
+	default:
+		return nil
+	}
+}
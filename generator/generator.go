@@ -5,40 +5,94 @@ package generator
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/chakrit/rpc/generator/elm"
+	"github.com/chakrit/rpc/generator/external"
 	"github.com/chakrit/rpc/generator/golang"
+	"github.com/chakrit/rpc/generator/jsonschema"
+	"github.com/chakrit/rpc/generator/kotlin"
+	"github.com/chakrit/rpc/generator/openapi"
+	"github.com/chakrit/rpc/generator/python"
+	"github.com/chakrit/rpc/generator/swift"
+	"github.com/chakrit/rpc/generator/tmpldata"
+	"github.com/chakrit/rpc/generator/ts"
 	"github.com/chakrit/rpc/internal"
 	"github.com/chakrit/rpc/spec"
 )
 
-type Func func(ns *spec.Namespace, outdir string) error
+type Func func(ns *spec.Namespace, outdir string, templates tmpldata.Overlay) error
 
 type Interface interface {
-	Generate(ns *spec.Namespace, outdir string) error
+	Generate(ns *spec.Namespace, outdir string, templates tmpldata.Overlay) error
 }
 
 type Options struct {
 	Logger internal.Logger
 	OutDir string
 	Target string
+
+	// Templates is a folder of templates overlaying those of the built-in generators, by
+	// their path or base name. The go generator also renders the other templates it holds
+	// under `golang/`.
+	Templates string
 }
 
 // added inside each implementation's init()
 var implementations = map[string]Func{
-	"elm": elm.Generate,
-	"go":  golang.Generate,
+	"elm":    elm.Generate,
+	"go":     golang.Generate,
+	"kotlin": kotlin.Generate,
+	"python": python.Generate,
+	"swift":  swift.Generate,
+	"ts":     ts.Generate,
+}
+
+// generators that render no templates, so Options.Templates has nothing to replace
+var templateless = map[string]func(ns *spec.Namespace, outdir string) error{
+	"jsonschema": jsonschema.Generate,
+	"openapi":    openapi.Generate,
+}
+
+// Templated reports whether the target renders templates that Options.Templates can
+// replace or add to.
+func Templated(target string) bool {
+	_, ok := implementations[target]
+	return ok
 }
 
 func Generate(ns *spec.Namespace, opt *Options) error {
 	generate, ok := implementations[opt.Target]
+	if plain, isPlain := templateless[opt.Target]; isPlain {
+		generate, ok = withoutTemplates(plain), true
+	}
+	if strings.HasPrefix(opt.Target, external.TargetPrefix) {
+		command := strings.TrimPrefix(opt.Target, external.TargetPrefix)
+		generate, ok = withoutTemplates(external.Generator(command)), true
+	}
 	if !ok {
 		return errors.New("unsupported target `" + opt.Target + "`")
 	}
 
-	if err := generate(ns, opt.OutDir); err != nil {
+	var templates tmpldata.Overlay
+	if opt.Templates != "" {
+		overlay, err := tmpldata.NewOverlay(opt.Templates)
+		if err != nil {
+			return err
+		}
+		templates = overlay
+	}
+
+	if err := generate(ns, opt.OutDir, templates); err != nil {
 		return fmt.Errorf("generator failure: %w", err)
 	} else {
 		return nil
 	}
 }
+
+// withoutTemplates adapts generators that have no templates to overlay.
+func withoutTemplates(generate func(ns *spec.Namespace, outdir string) error) Func {
+	return func(ns *spec.Namespace, outdir string, _ tmpldata.Overlay) error {
+		return generate(ns, outdir)
+	}
+}
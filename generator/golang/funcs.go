@@ -1,19 +1,48 @@
 package golang
 
 import (
+	"sort"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/chakrit/rpc/internal"
+	"github.com/chakrit/rpc/spec"
 )
 
+// names that can't be used as-is for generated arguments, either Go keywords and
+// predeclared identifiers, or names used by locals and imports in the templates.
+var reservedNames = map[string]struct{}{
+	"break": {}, "case": {}, "chan": {}, "const": {}, "continue": {}, "default": {},
+	"defer": {}, "else": {}, "fallthrough": {}, "for": {}, "func": {}, "go": {},
+	"goto": {}, "if": {}, "import": {}, "interface": {}, "map": {}, "package": {},
+	"range": {}, "return": {}, "select": {}, "struct": {}, "switch": {}, "type": {},
+	"var": {},
+
+	"bool": {}, "byte": {}, "error": {}, "float32": {}, "float64": {}, "int": {},
+	"int64": {}, "string": {}, "nil": {}, "true": {}, "false": {},
+
+	"bytes": {}, "context": {}, "fmt": {}, "json": {}, "http": {}, "math": {},
+	"strings": {}, "time": {},
+	"c": {}, "ctx": {}, "err": {}, "payload": {}, "buf": {}, "req": {}, "resp": {},
+	"returns": {}, "result": {}, "method": {}, "thrown": {}, "decodeResult": {},
+}
+
 func funcMap(reg TypeRegistry) template.FuncMap {
 	f := template.FuncMap{}
 	f["pascal"] = internal.InflectPascal
 	f["snake"] = internal.InflectSnake
 	f["dash"] = internal.InflectDash
+	f["title"] = internal.InflectTitle
+	f["lower"] = strings.ToLower
 
 	f["context"] = tmplContext
+	f["structOf"] = structOf
 
+	f["godoc"] = godoc
+	f["jsonName"] = jsonName
+	f["annotationLiteral"] = annotationLiteral
+	f["argName"] = argName
 	f["resolve"] = reg.Resolve
 	f["asReference"] = asReference
 	f["asMarshalTarget"] = asMarshalTarget
@@ -22,6 +51,17 @@ func funcMap(reg TypeRegistry) template.FuncMap {
 	return f
 }
 
+// structContext carries a type or error declaration, along with the package it lives in,
+// into the shared template that renders them as Go structs.
+type structContext struct {
+	Pkg  *Pkg
+	Node spec.Node
+}
+
+func structOf(pkg *Pkg, node spec.Node) *structContext {
+	return &structContext{Pkg: pkg, Node: node}
+}
+
 func tmplContext(ctxPkg *Pkg, dataPkg *Pkg) *PkgContext {
 	return &PkgContext{
 		ContextPkg: ctxPkg,
@@ -29,6 +69,90 @@ func tmplContext(ctxPkg *Pkg, dataPkg *Pkg) *PkgContext {
 	}
 }
 
+// godoc renders a doc comment from the spec as `//` lines, each terminated by a newline
+// so it can be placed right before a declaration. A `@deprecated` annotation adds the
+// conventional Deprecated paragraph.
+func godoc(doc string, annotations ...spec.Annotations) string {
+	for _, list := range annotations {
+		if deprecated := list.Lookup("deprecated"); deprecated != nil {
+			message := deprecated.Arg(0)
+			if message == "" {
+				message = "this is no longer supported."
+			}
+			if doc != "" {
+				doc += "\n\n"
+			}
+			doc += "Deprecated: " + message
+		}
+	}
+
+	if doc == "" {
+		return ""
+	}
+
+	sb := &strings.Builder{}
+	for _, line := range strings.Split(doc, "\n") {
+		if line == "" {
+			sb.WriteString("//\n")
+		} else {
+			sb.WriteString("// " + line + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// jsonName returns the key used for the property on the wire, which may be overridden
+// with the `@json(name="...")` annotation.
+func jsonName(prop *spec.Property) string {
+	if name := prop.Annotations.Lookup("json").Param("name"); name != "" {
+		return name
+	}
+	return prop.Name
+}
+
+// annotationLiteral renders an annotation as a composite literal of the Annotation type
+// generated into the server package, so handlers can inspect it at runtime.
+func annotationLiteral(annotation *spec.Annotation) string {
+	sb := &strings.Builder{}
+	sb.WriteString("{Name: " + strconv.Quote(annotation.Name))
+	if len(annotation.Args) > 0 {
+		args := make([]string, len(annotation.Args))
+		for idx, arg := range annotation.Args {
+			args[idx] = strconv.Quote(arg)
+		}
+		sb.WriteString(", Args: []string{" + strings.Join(args, ", ") + "}")
+	}
+	if len(annotation.Params) > 0 {
+		keys := make([]string, 0, len(annotation.Params))
+		for key := range annotation.Params {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		params := make([]string, len(keys))
+		for idx, key := range keys {
+			params[idx] = strconv.Quote(key) + ": " + strconv.Quote(annotation.Params[key])
+		}
+		sb.WriteString(", Params: map[string]string{" + strings.Join(params, ", ") + "}")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// argName returns a Go identifier for the rpc input argument at the given index, falling
+// back to positional names for unnamed arguments.
+func argName(rpc *spec.RPC, index int) string {
+	if index >= len(rpc.InputNames) || rpc.InputNames[index] == "" {
+		return "arg" + strconv.Itoa(index)
+	}
+
+	name := internal.InflectCamel(rpc.InputNames[index])
+	if _, reserved := reservedNames[name]; reserved {
+		name += "_"
+	}
+	return name
+}
+
 func asReference(pkg *Pkg, rt ResolvedType) string {
 	if rt == nil {
 		// TODO: Warn about bad resolution
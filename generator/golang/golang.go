@@ -7,45 +7,69 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strings"
 	"text/template"
 	"time"
 
+	gen "github.com/chakrit/rpc/generator/internal"
 	"github.com/chakrit/rpc/generator/tmpldata"
 
 	"github.com/chakrit/rpc/spec"
 )
 
 const (
-	ImportOption  = "go_import"
-	PackageOption = "go_package"
-	OutName       = "rpc.go"
+	ImportOption    = "go_import"
+	PackageOption   = gen.PackageOption
+	EnumOption      = "go_enum_unknown"
+	TemplatesOption = "go_templates"
+	OutName         = "rpc.go"
 
 	SharedTemplateName = "/golang/shared.go.gotmpl"
 	PkgTemplateName    = "/golang/pkg.go.gotmpl"
 	ClientTemplateName = "/golang/client.go.gotmpl"
 	ServerTemplateName = "/golang/server.go.gotmpl"
+	TemplateDir        = "/golang"
 
-	DefaultPkgName    = "rpc"
+	DefaultPkgName    = gen.DefaultPackageName
 	DefaultImportPath = "go.example.com/rpc"
 )
 
-func Generate(ns *spec.Namespace, outdir string) error {
+// Generate writes the Go packages of ns under outdir. Without templates, those of the
+// go_templates option are used, relative to the spec file that sets it.
+func Generate(ns *spec.Namespace, outdir string, templates tmpldata.Overlay) error {
+	if value, ok := ns.Options[TemplatesOption]; ok && templates.Dir == "" {
+		dir, file := fmt.Sprint(value), ns.OptionPos(TemplatesOption).File
+		if !filepath.IsAbs(dir) && file != "" {
+			dir = filepath.Join(filepath.Dir(file), dir)
+		}
+
+		overlay, err := tmpldata.NewOverlay(dir)
+		if err != nil {
+			return fmt.Errorf("option "+TemplatesOption+": %w", err)
+		}
+		templates = overlay
+	}
+
 	pkg := newRootPkg(ns)
-	if err := writeRPCPackages(outdir, pkg); err != nil {
+	if err := writeRPCPackages(templates, outdir, pkg); err != nil {
+		return fmt.Errorf("go template failure: %w", err)
+	}
+	if err := writeClientPackage(templates, outdir, pkg); err != nil {
 		return fmt.Errorf("go template failure: %w", err)
 	}
-	if err := writeClientPackage(outdir, pkg); err != nil {
+	if err := writeServerPackage(templates, outdir, pkg); err != nil {
 		return fmt.Errorf("go template failure: %w", err)
 	}
-	if err := writeServerPackage(outdir, pkg); err != nil {
+	if err := writeExtras(templates, outdir, pkg); err != nil {
 		return fmt.Errorf("go template failure: %w", err)
 	}
 
 	return nil
 }
 
-func writeClientPackage(rootdir string, pkg *Pkg) error {
+func writeClientPackage(templates tmpldata.Overlay, rootdir string, pkg *Pkg) error {
 	return write(
+		templates,
 		path.Join(rootdir, "client/client.go"),
 		ClientTemplateName,
 		pkg.Registry,
@@ -56,8 +80,9 @@ func writeClientPackage(rootdir string, pkg *Pkg) error {
 	)
 }
 
-func writeServerPackage(rootdir string, pkg *Pkg) error {
+func writeServerPackage(templates tmpldata.Overlay, rootdir string, pkg *Pkg) error {
 	return write(
+		templates,
 		path.Join(rootdir, "server/server.go"),
 		ServerTemplateName,
 		pkg.Registry,
@@ -68,9 +93,9 @@ func writeServerPackage(rootdir string, pkg *Pkg) error {
 	)
 }
 
-func writeRPCPackages(rootdir string, pkg *Pkg) error {
+func writeRPCPackages(templates tmpldata.Overlay, rootdir string, pkg *Pkg) error {
 	outpath := path.Join(rootdir, pkg.FilePath)
-	if err := write(outpath, PkgTemplateName, pkg.Registry, pkg); err != nil {
+	if err := write(templates, outpath, PkgTemplateName, pkg.Registry, pkg); err != nil {
 		name := pkg.Name
 		if name == "" {
 			name = "root"
@@ -80,7 +105,7 @@ func writeRPCPackages(rootdir string, pkg *Pkg) error {
 	}
 
 	for _, child := range pkg.Children {
-		if err := writeRPCPackages(rootdir, child); err != nil {
+		if err := writeRPCPackages(templates, rootdir, child); err != nil {
 			return err
 		}
 	}
@@ -88,7 +113,41 @@ func writeRPCPackages(rootdir string, pkg *Pkg) error {
 	return nil
 }
 
-func write(outpath, tmplname string, registry TypeRegistry, data interface{}) error {
+// writeExtras renders the templates in the `golang/` folder of the overlay that don't
+// replace a built-in one, each with the root package as data, the same as the root
+// `rpc.go`. Their output goes to the same path relative to that folder under rootdir,
+// without the extension of the template.
+func writeExtras(templates tmpldata.Overlay, rootdir string, pkg *Pkg) error {
+	names, err := templates.Extras(TemplateDir)
+	if err != nil {
+		return fmt.Errorf("listing templates: %w", err)
+	}
+
+	for _, name := range names {
+		tmplContent, err := templates.ReadExtra(TemplateDir, name)
+		if err != nil {
+			return fmt.Errorf("reading template `"+name+"`: %w", err)
+		}
+
+		outpath := path.Join(rootdir, strings.TrimSuffix(name, tmpldata.TemplateExt))
+		if err := render(templates, outpath, name, tmplContent, pkg.Registry, pkg); err != nil {
+			return fmt.Errorf("generating `"+outpath+"`: %w", err)
+		}
+	}
+	return nil
+}
+
+func write(templates tmpldata.Overlay, outpath, tmplname string, registry TypeRegistry, data interface{}) error {
+	tmplContent, err := templates.Read(tmplname)
+	if err != nil {
+		return fmt.Errorf("reading template `"+tmplname+"`: %w", err)
+	}
+	return render(templates, outpath, tmplname, tmplContent, registry, data)
+}
+
+// render renders the template along with the shared one, and formats the output when it
+// is Go code.
+func render(templates tmpldata.Overlay, outpath, tmplname, tmplContent string, registry TypeRegistry, data interface{}) error {
 	if err := os.MkdirAll(filepath.Dir(outpath), 0755); err != nil {
 		return fmt.Errorf("mkdir -p: %w", err)
 	}
@@ -98,16 +157,12 @@ func write(outpath, tmplname string, registry TypeRegistry, data interface{}) er
 	}
 	defer outfile.Close()
 
-	tmplContent, err := tmpldata.Read(tmplname)
-	if err != nil {
-		return fmt.Errorf("reading template `"+tmplname+"`: %w", err)
-	}
 	gotmpl, err := template.New(tmplname).Funcs(funcMap(registry)).Parse(tmplContent)
 	if err != nil {
 		return fmt.Errorf("parsing template `"+tmplname+"`: %w", err)
 	}
 
-	sharedContent, err := tmpldata.Read(SharedTemplateName)
+	sharedContent, err := templates.Read(SharedTemplateName)
 	if err != nil {
 		return fmt.Errorf("reading template `"+SharedTemplateName+"`: %w", err)
 	}
@@ -121,7 +176,9 @@ func write(outpath, tmplname string, registry TypeRegistry, data interface{}) er
 		return fmt.Errorf("rendering template: %w", err)
 	}
 
-	if err := gofmt(outpath); err != nil {
+	if filepath.Ext(outpath) != ".go" {
+		return nil
+	} else if err := gofmt(outpath); err != nil {
 		return fmt.Errorf("gofmt: %w", err)
 	}
 	return nil
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	gen "github.com/chakrit/rpc/generator/internal"
 	"github.com/chakrit/rpc/internal"
 	"github.com/chakrit/rpc/spec"
 )
@@ -90,7 +91,7 @@ func (pkg *Pkg) initialize() {
 		pkg.Name = strings.ToLower(pkg.Namespace.Name)
 	}
 
-	pkg.resolvePaths("", importOption)
+	pkg.resolvePaths("", importOption, gen.RPCPaths(pkg.Namespace))
 	pkg.generateNames()
 	pkg.Registry.RegisterAll(pkg)
 	pkg.resolveImports()
@@ -118,7 +119,22 @@ func (pkg *Pkg) lookupOption(name string) string {
 	}
 }
 
-func (pkg *Pkg) resolvePaths(base, importBase string) {
+// EnumFallback returns the member that unknown values of the enum are decoded into, or an
+// empty string if unknown values should be rejected instead, which they are unless the
+// go_enum_unknown option is "fallback".
+func (pkg *Pkg) EnumFallback(enum *spec.Enum) string {
+	if pkg.lookupOption(EnumOption) != "fallback" {
+		return ""
+	} else if fallback := enum.Annotations.Lookup("fallback"); fallback != nil {
+		return fallback.Arg(0)
+	} else if len(enum.Members) > 0 {
+		return enum.Members[0]
+	} else {
+		return ""
+	}
+}
+
+func (pkg *Pkg) resolvePaths(base, importBase string, rpcPaths map[*spec.Namespace]string) {
 	if pkg.Parent != nil {
 		base = path.Join(base, strings.ToLower(pkg.Name))
 	}
@@ -127,21 +143,20 @@ func (pkg *Pkg) resolvePaths(base, importBase string) {
 	pkg.FilePath = path.Join(base, OutName)
 	if pkg.Parent != nil {
 		pkg.ImportPath = path.Join(importBase, strings.ToLower(pkg.Name))
-		pkg.RPCPath = path.Join(pkg.Parent.RPCPath, internal.InflectSnake(pkg.Name))
 	} else {
 		pkg.ImportPath = importBase
-		pkg.RPCPath = path.Join(base, internal.InflectSnake(pkg.Name))
 	}
+	pkg.RPCPath = rpcPaths[pkg.Namespace]
 
 	for _, child := range pkg.Children {
-		child.resolvePaths(base, pkg.ImportPath)
+		child.resolvePaths(base, pkg.ImportPath, rpcPaths)
 	}
 }
 
 func (pkg *Pkg) resolveImports() {
 	dependencies := map[*Pkg]struct{}{}
-	check := func(ref *spec.TypeRef) {
-		resolved := pkg.Registry.Resolve(pkg, ref)
+	var checkResolved func(resolved ResolvedType)
+	checkResolved = func(resolved ResolvedType) {
 		if resolved == nil {
 			return
 		}
@@ -150,17 +165,23 @@ func (pkg *Pkg) resolveImports() {
 			dependencies[resolved.ImportPkg()] = struct{}{}
 		}
 		for _, arg := range resolved.Args() {
-			if arg != nil && arg.ImportPkg() != nil && arg.ImportPkg() != pkg {
-				dependencies[arg.ImportPkg()] = struct{}{}
-			}
+			checkResolved(arg)
 		}
 	}
+	check := func(ref *spec.TypeRef) {
+		checkResolved(pkg.Registry.Resolve(pkg, ref))
+	}
 
 	for _, typNode := range pkg.Namespace.Types {
 		for _, propNode := range typNode.(*spec.Type).Properties {
 			check(propNode.(*spec.Property).Type)
 		}
 	}
+	for _, errNode := range pkg.Namespace.Errors {
+		for _, propNode := range errNode.(*spec.Error).Properties {
+			check(propNode.(*spec.Property).Type)
+		}
+	}
 	for _, rpcNode := range pkg.Namespace.RPCs {
 		for _, typ := range rpcNode.(*spec.RPC).InputTypes {
 			check(typ)
@@ -12,6 +12,11 @@ func (r TypeRegistry) RegisterAll(pkg *Pkg) {
 		slug := r.slug(pkg, typ.Name)
 		r[slug] = rtUserDefined{typ.Name, pkg}
 	}
+	for _, errNode := range pkg.Namespace.Errors {
+		e := errNode.(*spec.Error)
+		slug := r.slug(pkg, e.Name)
+		r[slug] = rtUserDefined{e.Name, pkg}
+	}
 	for _, enumNode := range pkg.Namespace.Enums {
 		enum := enumNode.(*spec.Enum)
 		slug := r.slug(pkg, enum.Name)
@@ -31,6 +36,8 @@ func (r TypeRegistry) Resolve(pkg *Pkg, ref *spec.TypeRef) ResolvedType {
 		return r.resolveListType(pkg, ref)
 	case "map":
 		return r.resolveMapType(pkg, ref)
+	case "optional":
+		return r.resolveOptionalType(pkg, ref)
 	default:
 		return r.resolveCustomType(pkg, ref)
 	}
@@ -95,6 +102,16 @@ func (r TypeRegistry) resolveMapType(pkg *Pkg, ref *spec.TypeRef) ResolvedType {
 	}
 }
 
+func (r TypeRegistry) resolveOptionalType(pkg *Pkg, ref *spec.TypeRef) ResolvedType {
+	switch len(ref.Arguments) {
+	case 0:
+		// TODO: Emit a warning, optional with no type arg
+		return rtOptional{unknownType}
+	default:
+		return rtOptional{r.Resolve(pkg, ref.Arguments[0])}
+	}
+}
+
 func (r TypeRegistry) resolveCustomType(pkg *Pkg, ref *spec.TypeRef) ResolvedType {
 	for findPkg := pkg; findPkg != nil; findPkg = findPkg.Parent {
 		slug := r.slug(findPkg, ref.Name)
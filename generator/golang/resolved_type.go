@@ -37,6 +37,9 @@ type (
 		valueArg ResolvedType
 	}
 
+	// rtOptional is a pointer to the underlying type, nil being the absent value
+	rtOptional struct{ arg ResolvedType }
+
 	rtEnum struct {
 		name      string
 		importPkg *Pkg
@@ -94,6 +97,51 @@ func (t rtMap) AsReference(cur *Pkg) string {
 		"]" + t.valueArg.AsReference(cur)
 }
 
+func (t rtOptional) Name() string         { return "optional" }
+func (t rtOptional) Args() []ResolvedType { return []ResolvedType{t.arg} }
+func (t rtOptional) ImportPkg() *Pkg      { return nil }
+func (t rtOptional) AsReference(cur *Pkg) string {
+	if _, isPointer := t.arg.(rtUserDefined); isPointer {
+		return t.arg.AsReference(cur)
+	} else {
+		return "*" + t.arg.AsReference(cur)
+	}
+}
+
+// optionals of custom marshaled types marshal to pointers of the marshal target,
+// everything else is already marshaled correctly by encoding/json
+func (t rtOptional) AsMarshalTarget(cur *Pkg) string {
+	if m, ok := t.arg.(CustomMarshaler); ok {
+		return "*" + m.AsMarshalTarget(cur)
+	} else {
+		return t.AsReference(cur)
+	}
+}
+func (t rtOptional) AsMarshaler(cur *Pkg) string {
+	m, ok := t.arg.(CustomMarshaler)
+	if !ok {
+		return ""
+	}
+
+	return "(func(v " + t.AsReference(cur) + ") *" + m.AsMarshalTarget(cur) + " {" +
+		"if v == nil { return nil };" +
+		"out := " + m.AsMarshaler(cur) + "(*v);" +
+		"return &out;" +
+		"})"
+}
+func (t rtOptional) AsUnmarshaler(cur *Pkg) string {
+	m, ok := t.arg.(CustomMarshaler)
+	if !ok {
+		return ""
+	}
+
+	return "(func(v *" + m.AsMarshalTarget(cur) + ") " + t.AsReference(cur) + " {" +
+		"if v == nil { return nil };" +
+		"out := " + m.AsUnmarshaler(cur) + "(*v);" +
+		"return &out;" +
+		"})"
+}
+
 func (t rtEnum) Name() string         { return t.name }
 func (t rtEnum) Args() []ResolvedType { return nil }
 func (t rtEnum) ImportPkg() *Pkg      { return t.importPkg }
@@ -104,17 +152,6 @@ func (t rtEnum) AsReference(cur *Pkg) string {
 		return t.importPkg.MangledName + "." + t.name
 	}
 }
-func (t rtEnum) AsMarshalTarget(cur *Pkg) string {
-	return "string"
-}
-func (t rtEnum) AsMarshaler(cur *Pkg) string {
-	ref := t.AsReference(cur)
-	return "(func(v " + ref + ") string { return string(v) })"
-}
-func (t rtEnum) AsUnmarshaler(cur *Pkg) string {
-	ref := t.AsReference(cur)
-	return "(func(v string) " + ref + " { return " + ref + "(v) })"
-}
 
 func (t rtUserDefined) Name() string         { return t.name }
 func (t rtUserDefined) Args() []ResolvedType { return nil }
@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/chakrit/rpc/spec"
+)
+
+// Scope is the chain of namespaces that type references are resolved in, innermost last.
+type Scope []*spec.Namespace
+
+// Lookup finds the type or enum with the given name, searching outwards from the
+// innermost namespace the same way the validator does.
+func (sc Scope) Lookup(name string) spec.Node {
+	for idx := len(sc) - 1; idx >= 0; idx-- {
+		if node, ok := sc[idx].Types[name]; ok {
+			return node
+		} else if node, ok := sc[idx].Enums[name]; ok {
+			return node
+		}
+	}
+	return nil
+}
+
+// Mapper describes spec types the way the generated Go code puts them on the wire. Ref
+// returns the `$ref` for user-defined types and enums.
+type Mapper struct {
+	Ref func(node spec.Node) string
+}
+
+// Object describes a type or error declaration. The Go server always sends every
+// property, so all of them are required.
+func (m *Mapper) Object(sc Scope, doc string, annotations spec.Annotations, props spec.Mappings) *Schema {
+	schema := &Schema{
+		Type:        "object",
+		Description: Describe(doc, annotations),
+		Deprecated:  annotations.Has("deprecated"),
+		Properties:  map[string]*Schema{},
+	}
+
+	for _, node := range props.SortedByName() {
+		prop := node.(*spec.Property)
+		name := prop.Name
+		if jsonName := prop.Annotations.Lookup("json").Param("name"); jsonName != "" {
+			name = jsonName
+		}
+
+		propSchema := m.property(sc, prop.Type)
+		if description := Describe(prop.Doc, prop.Annotations); description != "" {
+			propSchema.Description = description
+		}
+		propSchema.Deprecated = prop.Annotations.Has("deprecated")
+		schema.Properties[name] = propSchema
+		schema.Required = append(schema.Required, name)
+	}
+	return schema
+}
+
+// property describes a struct property. Only `time` properties, optional or not, are
+// converted to unix seconds by the generated MarshalJSON. Times anywhere else are left
+// to encoding/json.
+func (m *Mapper) property(sc Scope, ref *spec.TypeRef) *Schema {
+	switch {
+	case ref.Name == "time":
+		return unixTime()
+	case ref.Name == "optional" && len(ref.Arguments) > 0 && ref.Arguments[0].Name == "time":
+		return &Schema{AnyOf: []*Schema{unixTime(), {Type: "null"}}}
+	default:
+		return m.Value(sc, ref)
+	}
+}
+
+// Value describes a value as encoding/json marshals the generated Go type, which is how
+// rpc arguments, return values and the contents of lists and maps are sent. Nil slices
+// and maps are sent as null, so lists, maps and data may be null as well.
+func (m *Mapper) Value(sc Scope, ref *spec.TypeRef) *Schema {
+	switch ref.Name {
+	case "unit":
+		return &Schema{Type: "object"}
+	case "string":
+		return &Schema{Type: "string"}
+	case "bool":
+		return &Schema{Type: "boolean"}
+	case "int":
+		return &Schema{Type: "integer", Format: "int32"}
+	case "long":
+		return &Schema{Type: "integer", Format: "int64"}
+	case "float":
+		return &Schema{Type: "number", Format: "float"}
+	case "double":
+		return &Schema{Type: "number", Format: "double"}
+	case "time":
+		return &Schema{Type: "string", Format: "date-time"}
+	case "data":
+		return &Schema{Type: []string{"string", "null"}, ContentEncoding: "base64"}
+	case "list":
+		return &Schema{Type: []string{"array", "null"}, Items: m.arg(sc, ref, 0)}
+	case "map":
+		schema := &Schema{Type: []string{"object", "null"}, AdditionalProperties: m.arg(sc, ref, 1)}
+		if len(ref.Arguments) > 0 && ref.Arguments[0].Name != "string" {
+			schema.PropertyNames = m.arg(sc, ref, 0)
+		}
+		return schema
+	case "optional":
+		return &Schema{AnyOf: []*Schema{m.arg(sc, ref, 0), {Type: "null"}}}
+	}
+
+	if node := sc.Lookup(ref.Name); node != nil {
+		return &Schema{Ref: m.Ref(node)}
+	}
+	return &Schema{} // unknown types are rejected by the validator
+}
+
+func (m *Mapper) arg(sc Scope, ref *spec.TypeRef, index int) *Schema {
+	if index >= len(ref.Arguments) {
+		return &Schema{}
+	}
+	return m.Value(sc, ref.Arguments[index])
+}
+
+func unixTime() *Schema {
+	return &Schema{Type: "number", Format: "double", Description: "Unix time in seconds."}
+}
+
+// Enum describes an enum by its wire values, listing the members in the description when
+// the values alone don't tell what they are.
+func Enum(enum *spec.Enum) *Schema {
+	schema := &Schema{
+		Type:        "string",
+		Description: Describe(enum.Doc, enum.Annotations),
+		Deprecated:  enum.Annotations.Has("deprecated"),
+	}
+	if enum.Integer {
+		schema.Type = "integer"
+	}
+
+	documented := enum.Integer
+	lines := make([]string, 0, len(enum.Members))
+	for _, member := range enum.Members {
+		value := enum.Value(member)
+		if enum.Integer {
+			number, _ := strconv.ParseInt(value, 10, 64)
+			schema.Enum = append(schema.Enum, number)
+		} else {
+			schema.Enum = append(schema.Enum, value)
+		}
+
+		line := "* `" + value + "` " + member
+		if doc := enum.MemberDocs[member]; doc != "" {
+			documented = true
+			line += ": " + strings.ReplaceAll(doc, "\n", " ")
+		}
+		lines = append(lines, line)
+	}
+
+	if documented {
+		if schema.Description != "" {
+			schema.Description += "\n\n"
+		}
+		schema.Description += strings.Join(lines, "\n")
+	}
+	return schema
+}
+
+// Describe returns the doc comment, with the message of a `@deprecated` annotation added.
+func Describe(doc string, annotations spec.Annotations) string {
+	if message := annotations.Lookup("deprecated").Arg(0); message != "" {
+		if doc != "" {
+			doc += "\n\n"
+		}
+		doc += "Deprecated: " + message
+	}
+	return doc
+}
@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/chakrit/rpc/internal"
+	"github.com/chakrit/rpc/spec"
+)
+
+const (
+	// PackageOption names the Go package of the root namespace, which the rpcs of the root
+	// namespace are served under.
+	PackageOption      = "go_package"
+	DefaultPackageName = "rpc"
+)
+
+// RPCPaths returns the path that generated servers serve each namespace's rpcs under: the
+// Go package name of the root namespace, followed by the names of nested namespaces, all
+// snake_cased.
+func RPCPaths(root *spec.Namespace) map[*spec.Namespace]string {
+	name := DefaultPackageName
+	if root.Name != "" && root.Name != "root" {
+		name = strings.ToLower(root.Name)
+	} else if value, ok := root.Options[PackageOption]; ok {
+		name = fmt.Sprint(value)
+	}
+
+	paths := map[*spec.Namespace]string{}
+	var walk func(ns *spec.Namespace, rpcPath string)
+	walk = func(ns *spec.Namespace, rpcPath string) {
+		paths[ns] = rpcPath
+		for _, node := range ns.Children {
+			child := node.(*spec.Namespace)
+			walk(child, path.Join(rpcPath, internal.InflectSnake(strings.ToLower(child.Name))))
+		}
+	}
+
+	walk(root, internal.InflectSnake(name))
+	return paths
+}
@@ -0,0 +1,31 @@
+package internal
+
+// Schema is the subset of JSON Schema (draft 2020-12) used by the generators. Type is
+// either a name or a list of names. Items is either a *Schema or false, to close off the
+// positional arrays used for rpc arguments and return values.
+type Schema struct {
+	SchemaURI   string `json:"$schema,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Deprecated  bool   `json:"deprecated,omitempty"`
+
+	Type   interface{}   `json:"type,omitempty"`
+	Format string        `json:"format,omitempty"`
+	Const  interface{}   `json:"const,omitempty"`
+	Enum   []interface{} `json:"enum,omitempty"`
+	AnyOf  []*Schema     `json:"anyOf,omitempty"`
+	OneOf  []*Schema     `json:"oneOf,omitempty"`
+
+	ContentEncoding string `json:"contentEncoding,omitempty"`
+
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+
+	PrefixItems []*Schema   `json:"prefixItems,omitempty"`
+	Items       interface{} `json:"items,omitempty"`
+	MinItems    *int        `json:"minItems,omitempty"`
+	MaxItems    *int        `json:"maxItems,omitempty"`
+}
@@ -0,0 +1,79 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	gen "github.com/chakrit/rpc/generator/internal"
+	"github.com/chakrit/rpc/spec"
+)
+
+const (
+	SchemaURI = "https://json-schema.org/draft/2020-12/schema"
+	OutSuffix = ".schema.json"
+)
+
+// Generate writes a schema file for every type and enum, named after the namespaces it
+// is nested in, such as `todo.TodoItem.schema.json`. Files reference each other by name
+// so they must be kept together.
+func Generate(ns *spec.Namespace, outdir string) error {
+	names := map[spec.Node]string{}
+	schemas := map[string]*gen.Schema{}
+	mapper := &gen.Mapper{Ref: func(node spec.Node) string { return names[node] + OutSuffix }}
+
+	var walk func(parent gen.Scope, ns *spec.Namespace, prefix string)
+	walk = func(parent gen.Scope, ns *spec.Namespace, prefix string) {
+		sc := append(append(gen.Scope{}, parent...), ns)
+		for _, node := range ns.Types.SortedByName() {
+			names[node] = prefix + node.(*spec.Type).Name
+		}
+		for _, node := range ns.Enums.SortedByName() {
+			names[node] = prefix + node.(*spec.Enum).Name
+		}
+
+		for _, node := range ns.Types.SortedByName() {
+			typ := node.(*spec.Type)
+			schemas[names[node]] = mapper.Object(sc, typ.Doc, typ.Annotations, typ.Properties)
+		}
+		for _, node := range ns.Enums.SortedByName() {
+			schemas[names[node]] = gen.Enum(node.(*spec.Enum))
+		}
+
+		for _, node := range ns.Children.SortedByName() {
+			child := node.(*spec.Namespace)
+			walk(sc, child, prefix+child.Name+".")
+		}
+	}
+	walk(nil, ns, "")
+
+	if err := os.MkdirAll(outdir, 0755); err != nil {
+		return err
+	}
+
+	for name, schema := range schemas {
+		schema.SchemaURI = SchemaURI
+		schema.Title = name
+		if err := write(filepath.Join(outdir, name+OutSuffix), schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func write(filename string, schema *gen.Schema) error {
+	outfile, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer outfile.Close()
+
+	encoder := json.NewEncoder(outfile)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(schema); err != nil {
+		return fmt.Errorf("jsonschema encode failure: %w", err)
+	}
+	return nil
+}
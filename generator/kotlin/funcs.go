@@ -0,0 +1,45 @@
+package kotlin
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/chakrit/rpc/spec"
+)
+
+func funcMap() template.FuncMap {
+	f := template.FuncMap{}
+	f["quote"] = quote
+	f["kdoc"] = kdoc
+	return f
+}
+
+// quote returns a Kotlin string literal, escaping `$` so it isn't taken as a template.
+func quote(s string) string {
+	return strings.ReplaceAll(strconv.Quote(s), "$", `\$`)
+}
+
+// kdoc renders a doc comment from the spec as a `/** */` block, terminated by a newline and
+// the given indentation so it can be placed right before a declaration. A `@deprecated`
+// annotation adds a `@Deprecated` annotation so the compiler warns about uses.
+func kdoc(indent, doc string, annotations ...spec.Annotations) string {
+	sb := &strings.Builder{}
+	doc = strings.TrimSpace(strings.ReplaceAll(doc, "*/", "*\\/"))
+	if doc != "" && !strings.Contains(doc, "\n") {
+		sb.WriteString("/** " + doc + " */\n" + indent)
+	} else if doc != "" {
+		sb.WriteString("/**\n")
+		for _, line := range strings.Split(doc, "\n") {
+			sb.WriteString(indent + strings.TrimRight(" * "+line, " ") + "\n")
+		}
+		sb.WriteString(indent + " */\n" + indent)
+	}
+
+	for _, list := range annotations {
+		if deprecated := list.Lookup("deprecated"); deprecated != nil {
+			sb.WriteString("@Deprecated(" + quote(deprecated.Arg(0)) + ")\n" + indent)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,210 @@
+package kotlin
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/chakrit/rpc/generator/tmpldata"
+	"github.com/chakrit/rpc/spec"
+)
+
+const (
+	RpcTemplateName  = "/kotlin/Rpc.kt.gotmpl"
+	UtilTemplateName = "/kotlin/RpcUtil.kt.gotmpl"
+	RpcOutName       = "Rpc.kt"
+	UtilOutName      = "RpcUtil.kt"
+
+	PackageOption  = "kotlin_package"
+	DefaultPackage = "rpc"
+)
+
+type (
+	// Resolution is how a type reference is written in Kotlin, along with the expression
+	// of its serializer. Default is the value used when the JSON holds null, as the Go code
+	// sends for empty lists, maps and data, and With is the serializer properties of the
+	// type must be annotated with, if any.
+	Resolution struct {
+		Name       string
+		Serializer string
+		Default    string
+		With       string
+	}
+
+	Field struct {
+		Name     string
+		JSONName string
+		Doc      string
+		Type     *Resolution
+
+		Annotations spec.Annotations
+	}
+
+	// Type is a type declaration, or the details of an error declaration along with its
+	// code and `@status`, if any.
+	Type struct {
+		Name   string
+		Doc    string
+		Code   string
+		Status int
+		Fields []*Field
+
+		Annotations spec.Annotations
+	}
+
+	Member struct {
+		Name    string
+		Doc     string
+		Literal string
+	}
+
+	// Enum is an enum declaration. Fallback is the entry that unknown values are decoded
+	// into, given with `@fallback`, if any.
+	Enum struct {
+		Name          string
+		QualifiedName string
+		Doc           string
+		ValueType     string
+		Fallback      string
+		Members       []*Member
+
+		Annotations spec.Annotations
+	}
+
+	Arg struct {
+		Name string
+		Type *Resolution
+	}
+
+	// Thrown is an error an rpc declares with `throws`, thrown as its exception class with
+	// the details decoded.
+	Thrown struct {
+		Code    string
+		Details string
+		Class   string
+	}
+
+	RpcFunc struct {
+		Name    string
+		Doc     string
+		RPCPath string
+
+		Args    []*Arg
+		Returns []*Resolution
+		Errors  []*Thrown
+
+		Annotations spec.Annotations
+	}
+
+	// File is the data for the templates. Declarations holds the nested namespaces of
+	// Rpc.kt already rendered, while clients are rendered from the flattened list of
+	// modules.
+	File struct {
+		Package      string
+		Root         *Module
+		Declarations string
+		Modules      []*Module
+	}
+)
+
+// Decode returns the Kotlin expression decoding a JSON element with the serializer,
+// using the default for nulls if there is one.
+func (r *Resolution) Decode(expr string) string {
+	if r.Default == "" || r.Default == "null" {
+		return "rpcDecode(" + r.Serializer + ", " + expr + ")"
+	}
+	return "rpcDecode(" + r.Serializer + ", " + expr + ", " + r.Default + ")"
+}
+
+func Generate(ns *spec.Namespace, outdir string, templates tmpldata.Overlay) error {
+	root := newRootModule(ns)
+
+	tmpl, err := parse(templates, RpcTemplateName)
+	if err != nil {
+		return fmt.Errorf("kotlin template failure: %w", err)
+	}
+	declarations, err := renderDeclarations(tmpl, root)
+	if err != nil {
+		return fmt.Errorf("kotlin template failure: %w", err)
+	}
+
+	pkg := DefaultPackage
+	if value, ok := ns.Options[PackageOption]; ok {
+		pkg = fmt.Sprint(value)
+	}
+
+	file := &File{Package: pkg, Root: root, Declarations: declarations, Modules: root.flatten()}
+	if err := write(filepath.Join(outdir, RpcOutName), tmpl, file); err != nil {
+		return fmt.Errorf("kotlin template failure: %w", err)
+	}
+
+	utilTmpl, err := parse(templates, UtilTemplateName)
+	if err != nil {
+		return fmt.Errorf("kotlin template failure: %w", err)
+	}
+	if err := write(filepath.Join(outdir, UtilOutName), utilTmpl, file); err != nil {
+		return fmt.Errorf("kotlin template failure: %w", err)
+	}
+	return nil
+}
+
+func parse(templates tmpldata.Overlay, tmplname string) (*template.Template, error) {
+	tmplContent, err := templates.Read(tmplname)
+	if err != nil {
+		return nil, err
+	}
+	return template.New(tmplname).Funcs(funcMap()).Parse(tmplContent)
+}
+
+// renderDeclarations renders the types of the module followed by its children, each
+// wrapped in an indented object so names resolve the same way as in the spec, innermost
+// namespace first.
+func renderDeclarations(tmpl *template.Template, mod *Module) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.ExecuteTemplate(buf, "declarations", mod); err != nil {
+		return "", err
+	}
+
+	for _, child := range mod.Children {
+		body, err := renderDeclarations(tmpl, child)
+		if err != nil {
+			return "", err
+		}
+
+		buf.WriteString("\n" + kdoc("", child.Namespace.Doc))
+		buf.WriteString("object " + child.Name + " {\n")
+		for _, line := range strings.Split(strings.TrimSpace(body), "\n") {
+			if line != "" {
+				buf.WriteString("    " + line)
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.String(), nil
+}
+
+var (
+	trailingSpaces  = regexp.MustCompile(`(?m)[ \t]+$`)
+	extraBlankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+func write(outpath string, tmpl *template.Template, data interface{}) error {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outpath), 0755); err != nil {
+		return err
+	}
+	content := trailingSpaces.ReplaceAll(buf.Bytes(), nil)
+	content = extraBlankLines.ReplaceAll(content, []byte("\n\n"))
+	content = append(bytes.TrimRight(content, "\n"), '\n')
+	return ioutil.WriteFile(outpath, content, 0644)
+}
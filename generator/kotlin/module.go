@@ -0,0 +1,361 @@
+package kotlin
+
+import (
+	"strconv"
+
+	gen "github.com/chakrit/rpc/generator/internal"
+	"github.com/chakrit/rpc/internal"
+	"github.com/chakrit/rpc/spec"
+)
+
+// names that can't be used as-is for generated properties and arguments, either hard
+// keywords or names already used by the templates.
+var reservedNames = map[string]struct{}{
+	"as": {}, "break": {}, "class": {}, "continue": {}, "do": {}, "else": {}, "false": {},
+	"for": {}, "fun": {}, "if": {}, "in": {}, "interface": {}, "is": {}, "null": {},
+	"object": {}, "package": {}, "return": {}, "super": {}, "this": {}, "throw": {},
+	"true": {}, "try": {}, "typealias": {}, "typeof": {}, "val": {}, "var": {},
+	"when": {}, "while": {},
+
+	"headers": {}, "transport": {},
+}
+
+// Module is a namespace, rendered as a nested object holding its declarations, with a
+// client class for its rpcs.
+type Module struct {
+	Name       string
+	ClientName string
+	RPCPath    string
+	Namespace  *spec.Namespace
+
+	Types    []*Type
+	Errors   []*Type
+	Enums    []*Enum
+	RPCFuncs []*RpcFunc
+
+	Parent   *Module
+	Children []*Module
+
+	scope    gen.Scope
+	resolver *resolver
+}
+
+// resolver knows the qualified name of every declaration, as seen from the top of the
+// file, such as `Todos.Item`.
+type resolver struct {
+	names    map[spec.Node]string
+	rpcPaths map[*spec.Namespace]string
+}
+
+func newRootModule(ns *spec.Namespace) *Module {
+	r := &resolver{
+		names:    map[spec.Node]string{},
+		rpcPaths: gen.RPCPaths(ns),
+	}
+	r.register(ns, "")
+	return newModule(nil, ns, r)
+}
+
+func (r *resolver) register(ns *spec.Namespace, prefix string) {
+	for _, node := range ns.Types {
+		r.names[node] = prefix + node.(*spec.Type).Name
+	}
+	for _, node := range ns.Enums {
+		r.names[node] = prefix + node.(*spec.Enum).Name
+	}
+	for _, node := range ns.Errors {
+		r.names[node] = prefix + node.(*spec.Error).Name
+	}
+	for _, node := range ns.Children {
+		child := node.(*spec.Namespace)
+		r.register(child, prefix+child.Name+".")
+	}
+}
+
+func newModule(parent *Module, ns *spec.Namespace, r *resolver) *Module {
+	mod := &Module{
+		Name:       ns.Name,
+		ClientName: "Client",
+		RPCPath:    r.rpcPaths[ns],
+		Namespace:  ns,
+		Parent:     parent,
+		resolver:   r,
+	}
+	if parent == nil {
+		mod.Name = ""
+		mod.scope = gen.Scope{ns}
+	} else {
+		mod.scope = append(append(gen.Scope{}, parent.scope...), ns)
+		if parent.Parent == nil {
+			mod.ClientName = "Client_" + internal.InflectSnake(ns.Name)
+		} else {
+			mod.ClientName = parent.ClientName + "_" + internal.InflectSnake(ns.Name)
+		}
+	}
+
+	mod.resolveTypes()
+	mod.resolveRPCFuncs()
+	for _, node := range ns.Children.SortedByName() {
+		mod.Children = append(mod.Children, newModule(mod, node.(*spec.Namespace), r))
+	}
+	return mod
+}
+
+// flatten returns the module followed by all of its descendants.
+func (m *Module) flatten() []*Module {
+	modules := []*Module{m}
+	for _, child := range m.Children {
+		modules = append(modules, child.flatten()...)
+	}
+	return modules
+}
+
+// Property returns the name of the client property that holds the child's client.
+func (m *Module) Property() string {
+	return identifier(internal.InflectCamel(m.Name))
+}
+
+// ClientDoc returns the doc comment of the module's client class.
+func (m *Module) ClientDoc() string {
+	if m.Parent != nil {
+		return m.ClientName + " calls the rpcs of the `" + m.Name + "` namespace."
+	}
+
+	doc := "Client calls the rpcs of the spec, and those of nested namespaces through its\n" +
+		"properties. Calls block until the response arrives, and throw the exception class of\n" +
+		"the errors an rpc declares, an RPCError for other errors reported by the server, or a\n" +
+		"TransportError or DecodeError when the call itself fails."
+	if m.Namespace.Doc != "" {
+		doc = m.Namespace.Doc + "\n\n" + doc
+	}
+	return doc
+}
+
+func (m *Module) resolveTypes() {
+	for _, node := range m.Namespace.Types.SortedByName() {
+		typ := node.(*spec.Type)
+		m.Types = append(m.Types, m.newType(typ.Name, typ.Doc, typ.Properties, typ.Annotations))
+	}
+
+	for _, node := range m.Namespace.Errors.SortedByName() {
+		err := node.(*spec.Error)
+		kotlinType := m.newType(err.Name, err.Doc, err.Properties, err.Annotations)
+		kotlinType.Code = err.Code()
+		kotlinType.Status = err.Status()
+		m.Errors = append(m.Errors, kotlinType)
+	}
+
+	for _, node := range m.Namespace.Enums.SortedByName() {
+		enum := node.(*spec.Enum)
+		kotlinEnum := &Enum{
+			Name:          enum.Name,
+			QualifiedName: m.resolver.names[enum],
+			Doc:           enum.Doc,
+			ValueType:     "String",
+
+			Annotations: enum.Annotations,
+		}
+		if enum.Integer {
+			kotlinEnum.ValueType = "Int"
+		}
+		if fallback := enum.Annotations.Lookup("fallback").Arg(0); enum.HasMember(fallback) {
+			kotlinEnum.Fallback = identifier(fallback)
+		}
+
+		for _, member := range enum.Members {
+			literal := enum.Value(member)
+			if !enum.Integer {
+				literal = quote(literal)
+			}
+
+			kotlinEnum.Members = append(kotlinEnum.Members, &Member{
+				Name:    identifier(member),
+				Doc:     enum.MemberDocs[member],
+				Literal: literal,
+			})
+		}
+		m.Enums = append(m.Enums, kotlinEnum)
+	}
+}
+
+func (m *Module) newType(name, doc string, props spec.Mappings, annotations spec.Annotations) *Type {
+	kotlinType := &Type{
+		Name: name,
+		Doc:  doc,
+
+		Annotations: annotations,
+	}
+
+	for _, node := range props.SortedByName() {
+		prop := node.(*spec.Property)
+		jsonName := prop.Name
+		if name := prop.Annotations.Lookup("json").Param("name"); name != "" {
+			jsonName = name
+		}
+
+		kotlinType.Fields = append(kotlinType.Fields, &Field{
+			Name:     identifier(internal.InflectCamel(prop.Name)),
+			JSONName: jsonName,
+			Doc:      prop.Doc,
+			Type:     m.resolveProperty(prop.Type),
+
+			Annotations: prop.Annotations,
+		})
+	}
+	return kotlinType
+}
+
+func (m *Module) resolveRPCFuncs() {
+	// methods share the client class with the transport and the clients of children
+	taken := map[string]struct{}{"transport": {}}
+	for _, node := range m.Namespace.Children {
+		taken[identifier(internal.InflectCamel(node.(*spec.Namespace).Name))] = struct{}{}
+	}
+
+	for _, node := range m.Namespace.RPCs.SortedByName() {
+		rpc := node.(*spec.RPC)
+		name := identifier(internal.InflectCamel(rpc.Name))
+		if _, isTaken := taken[name]; isTaken {
+			name += "_"
+		}
+
+		fn := &RpcFunc{
+			Name:    name,
+			Doc:     rpc.Doc,
+			RPCPath: m.RPCPath + "/" + rpc.Name,
+
+			Annotations: rpc.Annotations,
+		}
+
+		for idx, ref := range rpc.InputTypes {
+			fn.Args = append(fn.Args, &Arg{Name: argName(rpc, idx), Type: m.resolve(ref)})
+		}
+		for _, ref := range rpc.OutputTypes {
+			fn.Returns = append(fn.Returns, m.resolve(ref))
+		}
+		for _, ref := range rpc.Errors {
+			if node := m.lookupError(ref.Name); node != nil {
+				qualified := m.resolver.names[node]
+				fn.Errors = append(fn.Errors, &Thrown{
+					Code:    node.Code(),
+					Details: qualified,
+					Class:   qualified + "Error",
+				})
+			}
+		}
+
+		m.RPCFuncs = append(m.RPCFuncs, fn)
+	}
+}
+
+// identifier returns the name, with an underscore appended when it is reserved.
+func identifier(name string) string {
+	if _, reserved := reservedNames[name]; reserved {
+		return name + "_"
+	}
+	return name
+}
+
+// argName returns a Kotlin parameter name for the rpc input argument at the given index,
+// falling back to positional names for unnamed arguments.
+func argName(rpc *spec.RPC, index int) string {
+	if index >= len(rpc.InputNames) || rpc.InputNames[index] == "" {
+		return "arg" + strconv.Itoa(index)
+	}
+	return identifier(internal.InflectCamel(rpc.InputNames[index]))
+}
+
+func (m *Module) lookupError(name string) *spec.Error {
+	for idx := len(m.scope) - 1; idx >= 0; idx-- {
+		if node, ok := m.scope[idx].Errors[name]; ok {
+			return node.(*spec.Error)
+		}
+	}
+	return nil
+}
+
+// resolveProperty resolves the type of a property. Like the generated Go code, only
+// `time` properties, optional or not, are sent as unix seconds.
+func (m *Module) resolveProperty(ref *spec.TypeRef) *Resolution {
+	switch {
+	case ref.Name == "time":
+		return &Resolution{Name: "Instant", Serializer: "RpcUnixTimeSerializer", With: "RpcUnixTimeSerializer"}
+	case ref.Name == "optional" && len(ref.Arguments) > 0 && ref.Arguments[0].Name == "time":
+		return &Resolution{
+			Name:       "Instant?",
+			Serializer: "RpcOptionalUnixTimeSerializer",
+			Default:    "null",
+			With:       "RpcOptionalUnixTimeSerializer",
+		}
+	default:
+		return m.resolve(ref)
+	}
+}
+
+// resolve resolves the type of anything sent as-is by encoding/json in the generated Go
+// code, such as rpc arguments, return values and the contents of lists and maps.
+func (m *Module) resolve(ref *spec.TypeRef) *Resolution {
+	switch ref.Name {
+	case "unit":
+		return &Resolution{Name: "RpcUnit", Serializer: "RpcUnit.serializer()", Default: "RpcUnit"}
+	case "string":
+		return builtin("String")
+	case "bool":
+		return builtin("Boolean")
+	case "int":
+		return builtin("Int")
+	case "long":
+		return builtin("Long")
+	case "float":
+		return builtin("Float")
+	case "double":
+		return builtin("Double")
+	case "time":
+		return &Resolution{Name: "Instant", Serializer: "RpcTimeSerializer"}
+	case "data":
+		return &Resolution{Name: "ByteArray", Serializer: "RpcDataSerializer", Default: "ByteArray(0)"}
+	case "list":
+		element := m.resolveArg(ref, 0)
+		return &Resolution{
+			Name:       "List<" + element.Name + ">",
+			Serializer: "ListSerializer(" + element.Serializer + ")",
+			Default:    "emptyList()",
+		}
+	case "map":
+		key, value := m.resolveArg(ref, 0), m.resolveArg(ref, 1)
+		return &Resolution{
+			Name:       "Map<" + key.Name + ", " + value.Name + ">",
+			Serializer: "MapSerializer(" + key.Serializer + ", " + value.Serializer + ")",
+			Default:    "emptyMap()",
+		}
+	case "optional":
+		inner := m.resolveArg(ref, 0)
+		return &Resolution{
+			Name:       inner.Name + "?",
+			Serializer: inner.Serializer + ".nullable",
+			Default:    "null",
+		}
+	}
+
+	if node := m.scope.Lookup(ref.Name); node != nil {
+		name := m.resolver.names[node]
+		if _, isEnum := node.(*spec.Enum); isEnum {
+			return &Resolution{Name: name, Serializer: name + ".Serializer"}
+		}
+		return &Resolution{Name: name, Serializer: name + ".serializer()"}
+	}
+	return unknown // rejected by the validator
+}
+
+var unknown = &Resolution{Name: "JsonElement", Serializer: "JsonElement.serializer()"}
+
+func (m *Module) resolveArg(ref *spec.TypeRef, index int) *Resolution {
+	if index >= len(ref.Arguments) {
+		return unknown
+	}
+	return m.resolve(ref.Arguments[index])
+}
+
+func builtin(name string) *Resolution {
+	return &Resolution{Name: name, Serializer: name + ".serializer()"}
+}
@@ -0,0 +1,58 @@
+package openapi
+
+import gen "github.com/chakrit/rpc/generator/internal"
+
+// The subset of the OpenAPI 3.1 document structure used by the generator.
+type (
+	Document struct {
+		OpenAPI    string               `json:"openapi"`
+		Info       *Info                `json:"info"`
+		Servers    []*Server            `json:"servers,omitempty"`
+		Paths      map[string]*PathItem `json:"paths"`
+		Components *Components          `json:"components"`
+	}
+
+	Info struct {
+		Title       string `json:"title"`
+		Version     string `json:"version"`
+		Description string `json:"description,omitempty"`
+	}
+
+	Server struct {
+		URL string `json:"url"`
+	}
+
+	PathItem struct {
+		Post *Operation `json:"post"`
+	}
+
+	Operation struct {
+		OperationID string               `json:"operationId"`
+		Description string               `json:"description,omitempty"`
+		Tags        []string             `json:"tags,omitempty"`
+		Deprecated  bool                 `json:"deprecated,omitempty"`
+		RequestBody *RequestBody         `json:"requestBody"`
+		Responses   map[string]*Response `json:"responses"`
+	}
+
+	RequestBody struct {
+		Required bool                  `json:"required"`
+		Content  map[string]*MediaType `json:"content"`
+	}
+
+	Response struct {
+		Description string                `json:"description"`
+		Content     map[string]*MediaType `json:"content,omitempty"`
+	}
+
+	MediaType struct {
+		Schema *Schema `json:"schema"`
+	}
+
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	}
+
+	// Schema is a JSON Schema, which OpenAPI 3.1 uses as-is.
+	Schema = gen.Schema
+)
@@ -0,0 +1,274 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	gen "github.com/chakrit/rpc/generator/internal"
+	"github.com/chakrit/rpc/spec"
+)
+
+const (
+	OutName       = "openapi.json"
+	TitleOption   = "openapi_title"
+	VersionOption = "openapi_version"
+	ServerOption  = "openapi_server"
+
+	DefaultTitle   = "RPC"
+	DefaultVersion = "0.0.0"
+
+	// schema for errors not declared in the spec, named so it can't clash with types
+	errorSchemaName = "rpc.Error"
+)
+
+type builder struct {
+	doc    *Document
+	names  map[spec.Node]string
+	mapper *gen.Mapper
+
+	// paths of the Go server routes for each namespace
+	rpcPaths map[*spec.Namespace]string
+}
+
+func Generate(ns *spec.Namespace, outdir string) error {
+	b := &builder{names: map[spec.Node]string{}}
+	b.mapper = &gen.Mapper{Ref: func(node spec.Node) string { return schemaRef(b.names[node]) }}
+	b.build(ns)
+
+	if err := os.MkdirAll(outdir, 0755); err != nil {
+		return err
+	}
+
+	outfile, err := os.Create(filepath.Join(outdir, OutName))
+	if err != nil {
+		return err
+	}
+	defer outfile.Close()
+
+	encoder := json.NewEncoder(outfile)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(b.doc); err != nil {
+		return fmt.Errorf("openapi encode failure: %w", err)
+	}
+	return nil
+}
+
+func (b *builder) build(root *spec.Namespace) {
+	option := func(name, fallback string) string {
+		if value, ok := root.Options[name]; ok {
+			return fmt.Sprint(value)
+		}
+		return fallback
+	}
+
+	b.doc = &Document{
+		OpenAPI: "3.1.0",
+		Info: &Info{
+			Title:       option(TitleOption, DefaultTitle),
+			Version:     option(VersionOption, DefaultVersion),
+			Description: root.Doc,
+		},
+		Paths: map[string]*PathItem{},
+		Components: &Components{Schemas: map[string]*Schema{
+			errorSchemaName: {
+				Type: "object",
+				Properties: map[string]*Schema{
+					"code":    {Type: "string"},
+					"message": {Type: "string"},
+					"details": {},
+				},
+				Required: []string{"code", "message"},
+			},
+		}},
+	}
+	if server := option(ServerOption, ""); server != "" {
+		b.doc.Servers = []*Server{{URL: server}}
+	}
+
+	b.rpcPaths = gen.RPCPaths(root)
+	b.addNamespace(nil, root, "")
+}
+
+// addNamespace adds schemas for the definitions in the namespace, then its rpcs, and then
+// recurses into its children. References only ever point to the current or outer scopes,
+// so their schema names are always known by the time they are needed.
+func (b *builder) addNamespace(parent gen.Scope, ns *spec.Namespace, prefix string) {
+	sc := append(append(gen.Scope{}, parent...), ns)
+	for _, node := range ns.Types.SortedByName() {
+		b.names[node] = prefix + node.(*spec.Type).Name
+	}
+	for _, node := range ns.Enums.SortedByName() {
+		b.names[node] = prefix + node.(*spec.Enum).Name
+	}
+	for _, node := range ns.Errors.SortedByName() {
+		b.names[node] = prefix + node.(*spec.Error).Name
+	}
+
+	schemas := b.doc.Components.Schemas
+	for _, node := range ns.Types.SortedByName() {
+		typ := node.(*spec.Type)
+		schemas[b.names[node]] = b.mapper.Object(sc, typ.Doc, typ.Annotations, typ.Properties)
+	}
+	for _, node := range ns.Enums.SortedByName() {
+		schemas[b.names[node]] = gen.Enum(node.(*spec.Enum))
+	}
+	for _, node := range ns.Errors.SortedByName() {
+		err := node.(*spec.Error)
+		schemas[b.names[node]] = b.mapper.Object(sc, err.Doc, err.Annotations, err.Properties)
+	}
+
+	for _, node := range ns.RPCs.SortedByName() {
+		b.addRPC(sc, b.rpcPaths[ns], node.(*spec.RPC))
+	}
+
+	for _, node := range ns.Children.SortedByName() {
+		child := node.(*spec.Namespace)
+		b.addNamespace(sc, child, prefix+child.Name+".")
+	}
+}
+
+func (b *builder) addRPC(sc gen.Scope, rpcPath string, rpc *spec.RPC) {
+	operation := &Operation{
+		OperationID: strings.ReplaceAll(rpcPath, "/", ".") + "." + rpc.Name,
+		Description: gen.Describe(rpc.Doc, rpc.Annotations),
+		Tags:        []string{rpcPath},
+		Deprecated:  rpc.Annotations.Has("deprecated"),
+		RequestBody: &RequestBody{
+			Required: true,
+			Content:  jsonContent(b.tuple(sc, rpc.InputTypes, rpc.InputNames)),
+		},
+		Responses: map[string]*Response{
+			"200": {
+				Description: "The values returned by the call.",
+				Content:     jsonContent(envelope(nil, b.tuple(sc, rpc.OutputTypes, nil))),
+			},
+			"default": {
+				Description: "An error that is not declared by the call.",
+				Content:     jsonContent(envelope(&Schema{Ref: schemaRef(errorSchemaName)}, nil)),
+			},
+		},
+	}
+
+	// declared errors are grouped under the status the Go server responds with
+	var statuses []string
+	grouped := map[string][]*Schema{}
+	names := map[string][]string{}
+	for _, ref := range rpc.Errors {
+		node := lookupError(sc, ref.Name)
+		err, ok := node.(*spec.Error)
+		if !ok {
+			continue
+		}
+
+		status := strconv.Itoa(statusOf(err))
+		if _, exists := grouped[status]; !exists {
+			statuses = append(statuses, status)
+		}
+		grouped[status] = append(grouped[status], &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"code":    {Type: "string", Const: err.Code()},
+				"message": {Type: "string"},
+				"details": {Ref: schemaRef(b.names[node])},
+			},
+			Required: []string{"code", "message", "details"},
+		})
+		names[status] = append(names[status], err.Name)
+	}
+
+	for _, status := range statuses {
+		errSchema := grouped[status][0]
+		if len(grouped[status]) > 1 {
+			errSchema = &Schema{OneOf: grouped[status]}
+		}
+		operation.Responses[status] = &Response{
+			Description: "Declared errors: " + strings.Join(names[status], ", "),
+			Content:     jsonContent(envelope(errSchema, nil)),
+		}
+	}
+
+	b.doc.Paths["/"+rpcPath+"/"+rpc.Name] = &PathItem{Post: operation}
+}
+
+// tuple describes the positional JSON arrays that carry rpc arguments and return values.
+func (b *builder) tuple(sc gen.Scope, refs []*spec.TypeRef, names []string) *Schema {
+	count := len(refs)
+	schema := &Schema{
+		Type:     "array",
+		Items:    false,
+		MinItems: &count,
+		MaxItems: &count,
+	}
+
+	for idx, ref := range refs {
+		item := b.mapper.Value(sc, ref)
+		if idx < len(names) && names[idx] != "" {
+			item.Title = names[idx]
+		}
+		schema.PrefixItems = append(schema.PrefixItems, item)
+	}
+	return schema
+}
+
+// envelope describes the `{"error": ..., "returns": ...}` object that responses are
+// wrapped in. A nil schema stands for a null value.
+func envelope(errSchema, returnsSchema *Schema) *Schema {
+	if errSchema == nil {
+		errSchema = &Schema{Type: "null"}
+	}
+	if returnsSchema == nil {
+		returnsSchema = &Schema{Type: "null"}
+	}
+
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"error":   errSchema,
+			"returns": returnsSchema,
+		},
+		Required: []string{"error", "returns"},
+	}
+}
+
+// statusOf returns the HTTP status that the Go server's DefaultStatusFor responds with
+// for the error.
+func statusOf(err *spec.Error) int {
+	if status := err.Status(); status != 0 {
+		return status
+	}
+
+	switch err.Code() {
+	case "unauthenticated":
+		return 401
+	case "permission_denied":
+		return 403
+	case "not_found":
+		return 404
+	case "internal":
+		return 500
+	default:
+		return 400
+	}
+}
+
+func lookupError(sc gen.Scope, name string) spec.Node {
+	for idx := len(sc) - 1; idx >= 0; idx-- {
+		if node, ok := sc[idx].Errors[name]; ok {
+			return node
+		}
+	}
+	return nil
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
+
+func schemaRef(name string) string {
+	return "#/components/schemas/" + name
+}
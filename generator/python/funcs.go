@@ -0,0 +1,74 @@
+package python
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/chakrit/rpc/spec"
+)
+
+func funcMap() template.FuncMap {
+	f := template.FuncMap{}
+	f["quote"] = strconv.Quote
+	f["docstring"] = docstring
+	f["comment"] = comment
+	return f
+}
+
+// docstring renders a doc comment from the spec as a `"""` string, terminated by a newline
+// and the given indentation so it can be placed as the first statement of a class or
+// function body. A `@deprecated` annotation adds a line saying so.
+func docstring(indent, doc string, annotations ...spec.Annotations) string {
+	doc = describe(doc, annotations)
+	if doc == "" {
+		return ""
+	}
+
+	doc = strings.ReplaceAll(doc, `\`, `\\`)
+	doc = strings.ReplaceAll(doc, `"""`, `\"\"\"`)
+	if strings.HasSuffix(doc, `"`) {
+		doc = doc[:len(doc)-1] + `\"`
+	}
+	if !strings.Contains(doc, "\n") {
+		return `"""` + doc + `"""` + "\n" + indent
+	}
+
+	sb := &strings.Builder{}
+	sb.WriteString(`"""`)
+	for idx, line := range strings.Split(doc, "\n") {
+		if idx > 0 && line != "" {
+			sb.WriteString(indent)
+		}
+		sb.WriteString(line + "\n")
+	}
+	sb.WriteString(indent + `"""` + "\n" + indent)
+	return sb.String()
+}
+
+// comment renders a doc comment as `#` lines, for declarations that can't have docstrings
+// such as dataclass fields and enum members.
+func comment(indent, doc string, annotations ...spec.Annotations) string {
+	doc = describe(doc, annotations)
+	if doc == "" {
+		return ""
+	}
+
+	sb := &strings.Builder{}
+	for _, line := range strings.Split(doc, "\n") {
+		sb.WriteString(strings.TrimRight("# "+line, " ") + "\n" + indent)
+	}
+	return sb.String()
+}
+
+func describe(doc string, annotations []spec.Annotations) string {
+	for _, list := range annotations {
+		if deprecated := list.Lookup("deprecated"); deprecated != nil {
+			if doc != "" {
+				doc += "\n\n"
+			}
+			doc += strings.TrimSpace("Deprecated: " + deprecated.Arg(0))
+		}
+	}
+	return strings.TrimSpace(doc)
+}
@@ -0,0 +1,410 @@
+package python
+
+import (
+	"strconv"
+
+	gen "github.com/chakrit/rpc/generator/internal"
+	"github.com/chakrit/rpc/internal"
+	"github.com/chakrit/rpc/spec"
+)
+
+// names that can't be used as-is for generated attributes and arguments, either keywords
+// or names already used by the templates.
+var reservedNames = map[string]struct{}{
+	"False": {}, "None": {}, "True": {}, "and": {}, "as": {}, "assert": {}, "async": {},
+	"await": {}, "break": {}, "class": {}, "continue": {}, "def": {}, "del": {},
+	"elif": {}, "else": {}, "except": {}, "finally": {}, "for": {}, "from": {},
+	"global": {}, "if": {}, "import": {}, "in": {}, "is": {}, "lambda": {},
+	"nonlocal": {}, "not": {}, "or": {}, "pass": {}, "raise": {}, "return": {},
+	"try": {}, "while": {}, "with": {}, "yield": {},
+
+	"self": {}, "headers": {}, "rpc": {}, "typing": {}, "datetime": {}, "json": {},
+}
+
+// Module is a namespace, rendered as a nested class holding its declarations, with a
+// client class and a handler class for its rpcs.
+type Module struct {
+	Name        string
+	ClientName  string
+	HandlerName string
+	RPCPath     string
+	Namespace   *spec.Namespace
+
+	Types    []*Type
+	Errors   []*Type
+	Enums    []*Enum
+	RPCFuncs []*RpcFunc
+
+	Parent   *Module
+	Children []*Module
+
+	scope    gen.Scope
+	resolver *resolver
+}
+
+// resolver knows the qualified name of every declaration, as seen from the top of
+// rpc.py, such as `todos.Item`.
+type resolver struct {
+	names    map[spec.Node]string
+	rpcPaths map[*spec.Namespace]string
+}
+
+func newRootModule(ns *spec.Namespace) *Module {
+	r := &resolver{
+		names:    map[spec.Node]string{},
+		rpcPaths: gen.RPCPaths(ns),
+	}
+	r.register(ns, "")
+	return newModule(nil, ns, r)
+}
+
+func (r *resolver) register(ns *spec.Namespace, prefix string) {
+	for _, node := range ns.Types {
+		r.names[node] = prefix + node.(*spec.Type).Name
+	}
+	for _, node := range ns.Enums {
+		r.names[node] = prefix + node.(*spec.Enum).Name
+	}
+	for _, node := range ns.Errors {
+		r.names[node] = prefix + node.(*spec.Error).Name
+	}
+	for _, node := range ns.Children {
+		child := node.(*spec.Namespace)
+		r.register(child, prefix+child.Name+".")
+	}
+}
+
+func newModule(parent *Module, ns *spec.Namespace, r *resolver) *Module {
+	mod := &Module{
+		Name:        ns.Name,
+		ClientName:  "Client",
+		HandlerName: "Handler",
+		RPCPath:     r.rpcPaths[ns],
+		Namespace:   ns,
+		Parent:      parent,
+		resolver:    r,
+	}
+	if parent == nil {
+		mod.Name = ""
+		mod.scope = gen.Scope{ns}
+	} else {
+		mod.scope = append(append(gen.Scope{}, parent.scope...), ns)
+		suffix := "_" + internal.InflectSnake(ns.Name)
+		if parent.Parent == nil {
+			mod.ClientName, mod.HandlerName = "Client"+suffix, "Handler"+suffix
+		} else {
+			mod.ClientName, mod.HandlerName = parent.ClientName+suffix, parent.HandlerName+suffix
+		}
+	}
+
+	mod.resolveTypes()
+	mod.resolveRPCFuncs()
+	for _, node := range ns.Children.SortedByName() {
+		mod.Children = append(mod.Children, newModule(mod, node.(*spec.Namespace), r))
+	}
+	return mod
+}
+
+// flatten returns the module followed by all of its descendants.
+func (m *Module) flatten() []*Module {
+	modules := []*Module{m}
+	for _, child := range m.Children {
+		modules = append(modules, child.flatten()...)
+	}
+	return modules
+}
+
+// Attribute returns the name of the client and handler attributes that hold the child's
+// client and handler.
+func (m *Module) Attribute() string {
+	return identifier(internal.InflectSnake(m.Name))
+}
+
+// HandlerExpr returns the expression that reaches the module's handler from the root
+// handler in server.py, such as `handler.todos`.
+func (m *Module) HandlerExpr() string {
+	if m.Parent == nil {
+		return "handler"
+	}
+	return m.Parent.HandlerExpr() + "." + m.Attribute()
+}
+
+// ClientDoc returns the docstring of the module's client class.
+func (m *Module) ClientDoc() string {
+	if m.Parent != nil {
+		return m.ClientName + " calls the rpcs of the `" + m.Name + "` namespace."
+	}
+
+	doc := "Client calls the rpcs of the spec, and those of nested namespaces through its\n" +
+		"attributes. Calls raise the rpc.ThrownError subclass of the errors an rpc declares,\n" +
+		"an rpc.RPCError for other errors reported by the server, or a TransportError or\n" +
+		"DecodeError when the call itself fails."
+	if m.Namespace.Doc != "" {
+		doc = m.Namespace.Doc + "\n\n" + doc
+	}
+	return doc
+}
+
+// HandlerDoc returns the docstring of the module's handler class.
+func (m *Module) HandlerDoc() string {
+	if m.Parent != nil {
+		return m.HandlerName + " implements the rpcs of the `" + m.Name + "` namespace."
+	}
+
+	doc := "Handler implements the rpcs of the spec. Subclasses override the methods of the\n" +
+		"rpcs they serve and set the attributes of nested namespaces to their handlers.\n" +
+		"Methods raise rpc.ThrownError subclasses for the errors their rpc declares, or an\n" +
+		"rpc.RPCError with one of the well-known codes."
+	if m.Namespace.Doc != "" {
+		doc = m.Namespace.Doc + "\n\n" + doc
+	}
+	return doc
+}
+
+func (m *Module) resolveTypes() {
+	for _, node := range m.Namespace.Types.SortedByName() {
+		typ := node.(*spec.Type)
+		pyType := m.newType(typ.Name, typ.Doc, typ.Properties, typ.Annotations)
+		pyType.QualifiedName = m.resolver.names[typ]
+		m.Types = append(m.Types, pyType)
+	}
+
+	for _, node := range m.Namespace.Errors.SortedByName() {
+		err := node.(*spec.Error)
+		pyType := m.newType(err.Name, err.Doc, err.Properties, err.Annotations)
+		pyType.QualifiedName = m.resolver.names[err]
+		pyType.Code = err.Code()
+		pyType.Status = err.Status()
+		m.Errors = append(m.Errors, pyType)
+	}
+
+	for _, node := range m.Namespace.Enums.SortedByName() {
+		enum := node.(*spec.Enum)
+		pyEnum := &Enum{
+			Name:          enum.Name,
+			QualifiedName: m.resolver.names[enum],
+			Doc:           enum.Doc,
+			Integer:       enum.Integer,
+
+			Annotations: enum.Annotations,
+		}
+		if fallback := enum.Annotations.Lookup("fallback").Arg(0); enum.HasMember(fallback) {
+			pyEnum.Fallback = identifier(fallback)
+		}
+
+		for _, member := range enum.Members {
+			literal := enum.Value(member)
+			if !enum.Integer {
+				literal = strconv.Quote(literal)
+			}
+
+			pyEnum.Members = append(pyEnum.Members, &Member{
+				Name:    identifier(member),
+				Doc:     enum.MemberDocs[member],
+				Literal: literal,
+			})
+		}
+		m.Enums = append(m.Enums, pyEnum)
+	}
+}
+
+func (m *Module) newType(name, doc string, props spec.Mappings, annotations spec.Annotations) *Type {
+	pyType := &Type{
+		Name: name,
+		Doc:  doc,
+
+		Annotations: annotations,
+	}
+
+	for _, node := range props.SortedByName() {
+		prop := node.(*spec.Property)
+		jsonName := prop.Name
+		if name := prop.Annotations.Lookup("json").Param("name"); name != "" {
+			jsonName = name
+		}
+
+		pyType.Fields = append(pyType.Fields, &Field{
+			Name:     identifier(internal.InflectSnake(prop.Name)),
+			JSONName: jsonName,
+			Doc:      prop.Doc,
+			Type:     m.resolveProperty(prop.Type),
+
+			Annotations: prop.Annotations,
+		})
+	}
+	return pyType
+}
+
+func (m *Module) resolveRPCFuncs() {
+	// methods share the client and handler classes with the attributes of children
+	taken := map[string]struct{}{}
+	for _, node := range m.Namespace.Children {
+		taken[identifier(internal.InflectSnake(node.(*spec.Namespace).Name))] = struct{}{}
+	}
+
+	for _, node := range m.Namespace.RPCs.SortedByName() {
+		rpc := node.(*spec.RPC)
+		name := identifier(internal.InflectSnake(rpc.Name))
+		if _, isTaken := taken[name]; isTaken {
+			name += "_"
+		}
+
+		fn := &RpcFunc{
+			Name:    name,
+			Doc:     rpc.Doc,
+			RPCPath: m.RPCPath + "/" + rpc.Name,
+
+			Annotations: rpc.Annotations,
+		}
+
+		for idx, ref := range rpc.InputTypes {
+			fn.Args = append(fn.Args, &Arg{Name: argName(rpc, idx), Type: m.resolve(ref, "rpc.")})
+		}
+		for _, ref := range rpc.OutputTypes {
+			fn.Returns = append(fn.Returns, m.resolve(ref, "rpc."))
+		}
+		for _, ref := range rpc.Errors {
+			if node := m.lookupError(ref.Name); node != nil {
+				name := "rpc." + m.resolver.names[node]
+				fn.Errors = append(fn.Errors, &Thrown{
+					Code:   node.Code(),
+					Class:  name + "Error",
+					Decode: name + ".from_json",
+				})
+			}
+		}
+
+		m.RPCFuncs = append(m.RPCFuncs, fn)
+	}
+}
+
+// identifier returns the name, with an underscore appended when it is reserved.
+func identifier(name string) string {
+	if _, reserved := reservedNames[name]; reserved {
+		return name + "_"
+	}
+	return name
+}
+
+// argName returns a Python parameter name for the rpc input argument at the given index,
+// falling back to positional names for unnamed arguments.
+func argName(rpc *spec.RPC, index int) string {
+	if index >= len(rpc.InputNames) || rpc.InputNames[index] == "" {
+		return "arg" + strconv.Itoa(index)
+	}
+	return identifier(internal.InflectSnake(rpc.InputNames[index]))
+}
+
+func (m *Module) lookupError(name string) *spec.Error {
+	for idx := len(m.scope) - 1; idx >= 0; idx-- {
+		if node, ok := m.scope[idx].Errors[name]; ok {
+			return node.(*spec.Error)
+		}
+	}
+	return nil
+}
+
+// resolveProperty resolves the type of a property. Like the generated Go code, only
+// `time` properties, optional or not, are sent as unix seconds.
+func (m *Module) resolveProperty(ref *spec.TypeRef) *Resolution {
+	unixTime := &Resolution{
+		Name:   "datetime.datetime",
+		Encode: "encode_unix_time",
+		Decode: "decode_unix_time",
+	}
+
+	switch {
+	case ref.Name == "time":
+		return unixTime
+	case ref.Name == "optional" && len(ref.Arguments) > 0 && ref.Arguments[0].Name == "time":
+		return optional(unixTime, "")
+	default:
+		return m.resolve(ref, "")
+	}
+}
+
+// resolve resolves the type of anything sent as-is by encoding/json in the generated Go
+// code, such as rpc arguments, return values and the contents of lists and maps. Names
+// from rpc.py are prefixed with the given module prefix, which is empty inside rpc.py.
+func (m *Module) resolve(ref *spec.TypeRef, p string) *Resolution {
+	switch ref.Name {
+	case "unit":
+		return &Resolution{Name: "None", Encode: p + "encode_unit", Decode: p + "decode_unit"}
+	case "string":
+		return &Resolution{Name: "str", Encode: p + "encode_value", Decode: p + "decode_str"}
+	case "bool":
+		return &Resolution{Name: "bool", Encode: p + "encode_value", Decode: p + "decode_bool"}
+	case "int", "long":
+		return &Resolution{Name: "int", Encode: p + "encode_value", Decode: p + "decode_int"}
+	case "float", "double":
+		return &Resolution{Name: "float", Encode: p + "encode_value", Decode: p + "decode_float"}
+	case "time":
+		return &Resolution{Name: "datetime.datetime", Encode: p + "encode_time", Decode: p + "decode_time"}
+	case "data":
+		return &Resolution{Name: "bytes", Encode: p + "encode_data", Decode: p + "decode_data"}
+	case "list":
+		element := m.resolveArg(ref, 0, p)
+		return &Resolution{
+			Name:   "typing.List[" + element.Name + "]",
+			Encode: p + "encode_list(" + element.Encode + ")",
+			Decode: p + "decode_list(" + element.Decode + ")",
+		}
+	case "map":
+		key, value := m.resolveArg(ref, 0, p), m.resolveArg(ref, 1, p)
+		decodeKey := key.Decode
+		if m.isIntegerKey(ref) { // sent as strings, like every JSON object key
+			decodeKey = p + "decode_int_key(" + key.Decode + ")"
+		}
+		return &Resolution{
+			Name:   "typing.Dict[" + key.Name + ", " + value.Name + "]",
+			Encode: p + "encode_map(" + key.Encode + ", " + value.Encode + ")",
+			Decode: p + "decode_map(" + decodeKey + ", " + value.Decode + ")",
+		}
+	case "optional":
+		return optional(m.resolveArg(ref, 0, p), p)
+	}
+
+	switch node := m.scope.Lookup(ref.Name).(type) {
+	case *spec.Type:
+		name := p + m.resolver.names[node]
+		return &Resolution{Name: name, Encode: name + ".to_json", Decode: name + ".from_json"}
+	case *spec.Enum:
+		name := p + m.resolver.names[node]
+		return &Resolution{Name: name, Encode: p + "encode_enum", Decode: name}
+	}
+	return resolveUnknown(p) // unknown types are rejected by the validator
+}
+
+func (m *Module) resolveArg(ref *spec.TypeRef, index int, p string) *Resolution {
+	if index >= len(ref.Arguments) {
+		return resolveUnknown(p)
+	}
+	return m.resolve(ref.Arguments[index], p)
+}
+
+// isIntegerKey reports whether the keys of the map are integers or integer enums.
+func (m *Module) isIntegerKey(ref *spec.TypeRef) bool {
+	if len(ref.Arguments) == 0 {
+		return false
+	}
+
+	switch key := ref.Arguments[0].Name; key {
+	case "int", "long":
+		return true
+	default:
+		enum, ok := m.scope.Lookup(key).(*spec.Enum)
+		return ok && enum.Integer
+	}
+}
+
+func optional(inner *Resolution, p string) *Resolution {
+	return &Resolution{
+		Name:   "typing.Optional[" + inner.Name + "]",
+		Encode: p + "encode_optional(" + inner.Encode + ")",
+		Decode: p + "decode_optional(" + inner.Decode + ")",
+	}
+}
+
+func resolveUnknown(p string) *Resolution {
+	return &Resolution{Name: "typing.Any", Encode: p + "encode_value", Decode: p + "decode_value"}
+}
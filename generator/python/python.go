@@ -0,0 +1,217 @@
+package python
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/chakrit/rpc/generator/tmpldata"
+	"github.com/chakrit/rpc/spec"
+)
+
+const (
+	InitTemplateName   = "/python/__init__.py.gotmpl"
+	RpcTemplateName    = "/python/rpc.py.gotmpl"
+	ClientTemplateName = "/python/client.py.gotmpl"
+	ServerTemplateName = "/python/server.py.gotmpl"
+	InitOutName        = "__init__.py"
+	RpcOutName         = "rpc.py"
+	ClientOutName      = "client.py"
+	ServerOutName      = "server.py"
+)
+
+type (
+	// Resolution is how a type reference is written in Python, along with the functions
+	// that convert it to and from its JSON form.
+	Resolution struct {
+		Name   string
+		Encode string
+		Decode string
+	}
+
+	Field struct {
+		Name     string
+		JSONName string
+		Doc      string
+		Type     *Resolution
+
+		Annotations spec.Annotations
+	}
+
+	// Type is a type declaration, or the details of an error declaration along with its
+	// code and `@status`, if any. QualifiedName is the name as seen from the top of rpc.py.
+	Type struct {
+		Name          string
+		QualifiedName string
+		Doc           string
+		Code          string
+		Status        int
+		Fields        []*Field
+
+		Annotations spec.Annotations
+	}
+
+	Member struct {
+		Name    string
+		Doc     string
+		Literal string
+	}
+
+	// Enum is an enum declaration. Fallback is the member that unknown values are decoded
+	// into, given with `@fallback`, if any.
+	Enum struct {
+		Name          string
+		QualifiedName string
+		Doc           string
+		Integer       bool
+		Fallback      string
+		Members       []*Member
+
+		Annotations spec.Annotations
+	}
+
+	Arg struct {
+		Name string
+		Type *Resolution
+	}
+
+	// Thrown is an error an rpc declares with `throws`, raised as its exception class with
+	// the details decoded.
+	Thrown struct {
+		Code   string
+		Class  string
+		Decode string
+	}
+
+	RpcFunc struct {
+		Name    string
+		Doc     string
+		RPCPath string
+
+		Args    []*Arg
+		Returns []*Resolution
+		Errors  []*Thrown
+
+		Annotations spec.Annotations
+	}
+
+	// File is the data for the templates. Declarations holds the nested namespaces of
+	// rpc.py already rendered, while clients and handlers are rendered from the flattened
+	// list of modules.
+	File struct {
+		Root         *Module
+		Declarations string
+		Modules      []*Module
+	}
+)
+
+func Generate(ns *spec.Namespace, outdir string, templates tmpldata.Overlay) error {
+	root := newRootModule(ns)
+
+	tmpl, err := parse(templates, RpcTemplateName)
+	if err != nil {
+		return fmt.Errorf("python template failure: %w", err)
+	}
+	declarations, err := renderDeclarations(tmpl, root)
+	if err != nil {
+		return fmt.Errorf("python template failure: %w", err)
+	}
+
+	file := &File{Root: root, Declarations: declarations, Modules: root.flatten()}
+	if err := write(filepath.Join(outdir, RpcOutName), tmpl, file); err != nil {
+		return fmt.Errorf("python template failure: %w", err)
+	}
+
+	others := []struct{ tmplname, outname string }{
+		{InitTemplateName, InitOutName},
+		{ClientTemplateName, ClientOutName},
+		{ServerTemplateName, ServerOutName},
+	}
+	for _, other := range others {
+		tmpl, err := parse(templates, other.tmplname)
+		if err != nil {
+			return fmt.Errorf("python template failure: %w", err)
+		}
+		if err := write(filepath.Join(outdir, other.outname), tmpl, file); err != nil {
+			return fmt.Errorf("python template failure: %w", err)
+		}
+	}
+	return nil
+}
+
+func parse(templates tmpldata.Overlay, tmplname string) (*template.Template, error) {
+	tmplContent, err := templates.Read(tmplname)
+	if err != nil {
+		return nil, err
+	}
+	return template.New(tmplname).Funcs(funcMap()).Parse(tmplContent)
+}
+
+// renderDeclarations renders the types of the module followed by its children, each
+// wrapped in an indented class so names resolve the same way as in the spec, innermost
+// namespace first.
+func renderDeclarations(tmpl *template.Template, mod *Module) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.ExecuteTemplate(buf, "declarations", mod); err != nil {
+		return "", err
+	}
+
+	for _, child := range mod.Children {
+		body, err := renderDeclarations(tmpl, child)
+		if err != nil {
+			return "", err
+		}
+
+		doc := child.Namespace.Doc
+		if doc == "" {
+			doc = "Declarations of the `" + child.Name + "` namespace."
+		}
+
+		buf.WriteString("\n\nclass " + child.Name + ":\n")
+		buf.WriteString("    " + docstring("    ", doc) + "\n")
+		if body = strings.TrimSpace(body); body == "" {
+			continue
+		}
+
+		buf.WriteString("\n")
+		for _, line := range strings.Split(body, "\n") {
+			if line != "" {
+				buf.WriteString("    " + line)
+			}
+			buf.WriteString("\n")
+		}
+	}
+	return buf.String(), nil
+}
+
+var (
+	trailingSpaces  = regexp.MustCompile(`(?m)[ \t]+$`)
+	extraBlankLines = regexp.MustCompile(`\n{4,}`)
+
+	// indented lines, inside classes and functions, follow at most one blank line, and
+	// none right after a class without a docstring
+	extraIndentedBlankLines = regexp.MustCompile(`\n\n\n+( +\S)`)
+	blankAfterClass         = regexp.MustCompile(`(?m)^( *class .*:\n)\n+`)
+)
+
+func write(outpath string, tmpl *template.Template, data interface{}) error {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outpath), 0755); err != nil {
+		return err
+	}
+	content := trailingSpaces.ReplaceAll(buf.Bytes(), nil)
+	content = extraBlankLines.ReplaceAll(content, []byte("\n\n\n"))
+	content = extraIndentedBlankLines.ReplaceAll(content, []byte("\n\n$1"))
+	content = blankAfterClass.ReplaceAll(content, []byte("$1"))
+	content = append(bytes.TrimRight(content, "\n"), '\n')
+	return ioutil.WriteFile(outpath, content, 0644)
+}
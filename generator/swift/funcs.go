@@ -0,0 +1,39 @@
+package swift
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/chakrit/rpc/spec"
+)
+
+func funcMap() template.FuncMap {
+	f := template.FuncMap{}
+	f["quote"] = strconv.Quote
+	f["doc"] = doc
+	return f
+}
+
+// doc renders a doc comment from the spec as `///` lines, terminated by a newline and the
+// given indentation so it can be placed right before a declaration. A `@deprecated`
+// annotation adds an `@available` attribute so the compiler warns about uses.
+func doc(indent, doc string, annotations ...spec.Annotations) string {
+	sb := &strings.Builder{}
+	if doc = strings.TrimSpace(doc); doc != "" {
+		for _, line := range strings.Split(doc, "\n") {
+			sb.WriteString(strings.TrimRight("/// "+line, " ") + "\n" + indent)
+		}
+	}
+
+	for _, list := range annotations {
+		if deprecated := list.Lookup("deprecated"); deprecated != nil {
+			if message := deprecated.Arg(0); message != "" {
+				sb.WriteString("@available(*, deprecated, message: " + strconv.Quote(message) + ")\n" + indent)
+			} else {
+				sb.WriteString("@available(*, deprecated)\n" + indent)
+			}
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,394 @@
+package swift
+
+import (
+	"strconv"
+
+	gen "github.com/chakrit/rpc/generator/internal"
+	"github.com/chakrit/rpc/internal"
+	"github.com/chakrit/rpc/spec"
+)
+
+// names that can't be used as-is for generated properties, cases and arguments, either
+// keywords or names already used by the templates.
+var reservedNames = map[string]struct{}{
+	"associatedtype": {}, "class": {}, "deinit": {}, "enum": {}, "extension": {},
+	"fileprivate": {}, "func": {}, "import": {}, "init": {}, "inout": {}, "internal": {},
+	"let": {}, "open": {}, "operator": {}, "private": {}, "protocol": {}, "public": {},
+	"rethrows": {}, "static": {}, "struct": {}, "subscript": {}, "typealias": {},
+	"var": {}, "break": {}, "case": {}, "continue": {}, "default": {}, "defer": {},
+	"do": {}, "else": {}, "fallthrough": {}, "for": {}, "guard": {}, "if": {}, "in": {},
+	"repeat": {}, "return": {}, "switch": {}, "where": {}, "while": {}, "as": {},
+	"catch": {}, "false": {}, "is": {}, "nil": {}, "super": {}, "self": {}, "Self": {},
+	"throw": {}, "throws": {}, "true": {}, "try": {}, "await": {}, "async": {},
+
+	"headers": {}, "transport": {}, "container": {}, "decoder": {}, "encoder": {},
+}
+
+// Module is a namespace, rendered as a nested caseless enum holding its declarations,
+// with a client class for its rpcs.
+type Module struct {
+	Name       string
+	ClientName string
+	RPCPath    string
+	Namespace  *spec.Namespace
+
+	Types    []*Type
+	Errors   []*Type
+	Enums    []*Enum
+	RPCFuncs []*RpcFunc
+
+	Parent   *Module
+	Children []*Module
+
+	scope    gen.Scope
+	resolver *resolver
+}
+
+// resolver knows the qualified name of every declaration, as seen from the top of the
+// file, such as `Todos.Item`.
+type resolver struct {
+	names    map[spec.Node]string
+	rpcPaths map[*spec.Namespace]string
+}
+
+func newRootModule(ns *spec.Namespace) *Module {
+	r := &resolver{
+		names:    map[spec.Node]string{},
+		rpcPaths: gen.RPCPaths(ns),
+	}
+	r.register(ns, "")
+	return newModule(nil, ns, r)
+}
+
+func (r *resolver) register(ns *spec.Namespace, prefix string) {
+	for _, node := range ns.Types {
+		r.names[node] = prefix + node.(*spec.Type).Name
+	}
+	for _, node := range ns.Enums {
+		r.names[node] = prefix + node.(*spec.Enum).Name
+	}
+	for _, node := range ns.Errors {
+		r.names[node] = prefix + node.(*spec.Error).Name
+	}
+	for _, node := range ns.Children {
+		child := node.(*spec.Namespace)
+		r.register(child, prefix+child.Name+".")
+	}
+}
+
+func newModule(parent *Module, ns *spec.Namespace, r *resolver) *Module {
+	mod := &Module{
+		Name:       ns.Name,
+		ClientName: "Client",
+		RPCPath:    r.rpcPaths[ns],
+		Namespace:  ns,
+		Parent:     parent,
+		resolver:   r,
+	}
+	if parent == nil {
+		mod.Name = ""
+		mod.scope = gen.Scope{ns}
+	} else {
+		mod.scope = append(append(gen.Scope{}, parent.scope...), ns)
+		if parent.Parent == nil {
+			mod.ClientName = "Client_" + internal.InflectSnake(ns.Name)
+		} else {
+			mod.ClientName = parent.ClientName + "_" + internal.InflectSnake(ns.Name)
+		}
+	}
+
+	mod.resolveTypes()
+	mod.resolveRPCFuncs()
+	for _, node := range ns.Children.SortedByName() {
+		mod.Children = append(mod.Children, newModule(mod, node.(*spec.Namespace), r))
+	}
+	return mod
+}
+
+// flatten returns the module followed by all of its descendants.
+func (m *Module) flatten() []*Module {
+	modules := []*Module{m}
+	for _, child := range m.Children {
+		modules = append(modules, child.flatten()...)
+	}
+	return modules
+}
+
+// Property returns the name of the client property that holds the child's client.
+func (m *Module) Property() string {
+	return identifier(internal.InflectCamel(m.Name))
+}
+
+// ClientDoc returns the doc comment of the module's client class.
+func (m *Module) ClientDoc() string {
+	if m.Parent != nil {
+		return m.ClientName + " calls the rpcs of the `" + m.Name + "` namespace."
+	}
+
+	doc := "Client calls the rpcs of the spec, and those of nested namespaces through its\n" +
+		"properties. Calls throw a ThrownError with the details of the errors an rpc declares,\n" +
+		"an RPCError for other errors reported by the server, or a TransportError or\n" +
+		"DecodeError when the call itself fails."
+	if m.Namespace.Doc != "" {
+		doc = m.Namespace.Doc + "\n\n" + doc
+	}
+	return doc
+}
+
+func (m *Module) resolveTypes() {
+	for _, node := range m.Namespace.Types.SortedByName() {
+		typ := node.(*spec.Type)
+		m.Types = append(m.Types, m.newType(typ.Name, typ.Doc, typ.Properties, typ.Annotations))
+	}
+
+	for _, node := range m.Namespace.Errors.SortedByName() {
+		err := node.(*spec.Error)
+		swiftType := m.newType(err.Name, err.Doc, err.Properties, err.Annotations)
+		swiftType.Code = err.Code()
+		m.Errors = append(m.Errors, swiftType)
+	}
+
+	for _, node := range m.Namespace.Enums.SortedByName() {
+		enum := node.(*spec.Enum)
+		swiftEnum := &Enum{
+			Name:    enum.Name,
+			Doc:     enum.Doc,
+			RawType: "String",
+
+			Annotations: enum.Annotations,
+		}
+		if enum.Integer {
+			swiftEnum.RawType = "Int"
+		}
+		if fallback := enum.Annotations.Lookup("fallback").Arg(0); enum.HasMember(fallback) {
+			swiftEnum.Fallback = identifier(internal.InflectCamel(fallback))
+		}
+
+		for _, member := range enum.Members {
+			literal := enum.Value(member)
+			if !enum.Integer {
+				literal = strconv.Quote(literal)
+			}
+
+			swiftEnum.Members = append(swiftEnum.Members, &Member{
+				Name:    identifier(internal.InflectCamel(member)),
+				Doc:     enum.MemberDocs[member],
+				Literal: literal,
+			})
+		}
+		m.Enums = append(m.Enums, swiftEnum)
+	}
+}
+
+func (m *Module) newType(name, doc string, props spec.Mappings, annotations spec.Annotations) *Type {
+	swiftType := &Type{
+		Name: name,
+		Doc:  doc,
+
+		Annotations: annotations,
+	}
+
+	for _, node := range props.SortedByName() {
+		prop := node.(*spec.Property)
+		jsonName := prop.Name
+		if name := prop.Annotations.Lookup("json").Param("name"); name != "" {
+			jsonName = name
+		}
+
+		swiftType.Fields = append(swiftType.Fields, &Field{
+			Name:     identifier(internal.InflectCamel(prop.Name)),
+			JSONName: jsonName,
+			Doc:      prop.Doc,
+			Type:     m.resolveProperty(prop.Type),
+
+			Annotations: prop.Annotations,
+		})
+	}
+	return swiftType
+}
+
+func (m *Module) resolveRPCFuncs() {
+	// methods share the client class with the transport and the clients of children
+	taken := map[string]struct{}{"transport": {}}
+	for _, node := range m.Namespace.Children {
+		taken[identifier(internal.InflectCamel(node.(*spec.Namespace).Name))] = struct{}{}
+	}
+
+	for _, node := range m.Namespace.RPCs.SortedByName() {
+		rpc := node.(*spec.RPC)
+		name := identifier(internal.InflectCamel(rpc.Name))
+		if _, isTaken := taken[name]; isTaken {
+			name += "_"
+		}
+
+		fn := &RpcFunc{
+			Name:    name,
+			Doc:     rpc.Doc,
+			RPCPath: m.RPCPath + "/" + rpc.Name,
+
+			Annotations: rpc.Annotations,
+		}
+
+		for idx, ref := range rpc.InputTypes {
+			fn.Args = append(fn.Args, &Arg{Name: argName(rpc, idx), Type: m.resolve(ref)})
+		}
+		for _, ref := range rpc.OutputTypes {
+			fn.Returns = append(fn.Returns, m.resolve(ref))
+		}
+		for _, ref := range rpc.Errors {
+			if node := m.lookupError(ref.Name); node != nil {
+				fn.Errors = append(fn.Errors, &Thrown{
+					Code: node.Code(),
+					Type: m.resolver.names[node],
+				})
+			}
+		}
+
+		m.RPCFuncs = append(m.RPCFuncs, fn)
+	}
+}
+
+// identifier returns the name, with an underscore appended when it is reserved.
+func identifier(name string) string {
+	if _, reserved := reservedNames[name]; reserved {
+		return name + "_"
+	}
+	return name
+}
+
+// argName returns a Swift parameter name for the rpc input argument at the given index,
+// falling back to positional names for unnamed arguments.
+func argName(rpc *spec.RPC, index int) string {
+	if index >= len(rpc.InputNames) || rpc.InputNames[index] == "" {
+		return "arg" + strconv.Itoa(index)
+	}
+	return identifier(internal.InflectCamel(rpc.InputNames[index]))
+}
+
+func (m *Module) lookupError(name string) *spec.Error {
+	for idx := len(m.scope) - 1; idx >= 0; idx-- {
+		if node, ok := m.scope[idx].Errors[name]; ok {
+			return node.(*spec.Error)
+		}
+	}
+	return nil
+}
+
+// resolveProperty resolves the type of a property. Like the generated Go code, only
+// `time` properties, optional or not, are sent as unix seconds.
+func (m *Module) resolveProperty(ref *spec.TypeRef) *Resolution {
+	unixTime := wrapped("Date", "RpcUnixTime", "")
+
+	switch {
+	case ref.Name == "time":
+		return unixTime
+	case ref.Name == "optional" && len(ref.Arguments) > 0 && ref.Arguments[0].Name == "time":
+		return optional(unixTime)
+	default:
+		return m.resolve(ref)
+	}
+}
+
+// resolve resolves the type of anything sent as-is by encoding/json in the generated Go
+// code, such as rpc arguments, return values and the contents of lists and maps.
+func (m *Module) resolve(ref *spec.TypeRef) *Resolution {
+	switch ref.Name {
+	case "unit":
+		return &Resolution{Name: "RpcUnit", Wire: "RpcUnit", Default: "RpcUnit()"}
+	case "string":
+		return plain("String")
+	case "bool":
+		return plain("Bool")
+	case "int":
+		return plain("Int")
+	case "long":
+		return plain("Int64")
+	case "float":
+		return plain("Float")
+	case "double":
+		return plain("Double")
+	case "time":
+		return wrapped("Date", "RpcTime", "")
+	case "data":
+		return wrapped("Data", "RpcData", "Data()")
+	case "list":
+		element := m.resolveArg(ref, 0)
+		return &Resolution{
+			Name:     "[" + element.Name + "]",
+			Wire:     "RpcList<" + element.Wire + ">",
+			Default:  "[]",
+			toWire:   func(expr string) string { return "RpcList(" + mapped(expr, element.ToWire) + ")" },
+			fromWire: func(expr string) string { return mapped(expr+".value", element.FromWire) },
+		}
+	case "map":
+		key, value := m.resolveArg(ref, 0), m.resolveArg(ref, 1)
+		wire := "RpcMap<" + value.Wire + ">"
+		if key.Name != "String" { // enum keys
+			wire = "RpcEnumMap<" + key.Name + ", " + value.Wire + ">"
+		}
+		return &Resolution{
+			Name:     "[" + key.Name + ": " + value.Name + "]",
+			Wire:     wire,
+			Default:  "[:]",
+			toWire:   func(expr string) string { return wire + "(" + mappedValues(expr, value.ToWire) + ")" },
+			fromWire: func(expr string) string { return mappedValues(expr+".value", value.FromWire) },
+		}
+	case "optional":
+		return optional(m.resolveArg(ref, 0))
+	}
+
+	if node := m.scope.Lookup(ref.Name); node != nil {
+		return plain(m.resolver.names[node])
+	}
+	return &Resolution{Name: "RpcJSON", Wire: "RpcJSON"} // unknown types are rejected by the validator
+}
+
+func (m *Module) resolveArg(ref *spec.TypeRef, index int) *Resolution {
+	if index >= len(ref.Arguments) {
+		return &Resolution{Name: "RpcJSON", Wire: "RpcJSON"}
+	}
+	return m.resolve(ref.Arguments[index])
+}
+
+// plain resolves types that are sent as they are encoded by Codable.
+func plain(name string) *Resolution {
+	return &Resolution{Name: name, Wire: name}
+}
+
+// wrapped resolves types sent through one of the wrappers in RpcUtil.swift, which hold
+// the value in their value property.
+func wrapped(name, wire, empty string) *Resolution {
+	return &Resolution{
+		Name:     name,
+		Wire:     wire,
+		Default:  empty,
+		toWire:   func(expr string) string { return wire + "(" + expr + ")" },
+		fromWire: func(expr string) string { return expr + ".value" },
+	}
+}
+
+func optional(inner *Resolution) *Resolution {
+	return &Resolution{
+		Name:     inner.Name + "?",
+		Wire:     inner.Wire + "?",
+		Inner:    inner,
+		toWire:   func(expr string) string { return mapped(expr, inner.ToWire) },
+		fromWire: func(expr string) string { return mapped(expr, inner.FromWire) },
+	}
+}
+
+// mapped converts every element of the collection or optional in expr with the given
+// conversion, or returns expr as-is when there is nothing to convert.
+func mapped(expr string, convert func(string) string) string {
+	if body := convert("$0"); body != "$0" {
+		return expr + ".map { " + body + " }"
+	}
+	return expr
+}
+
+func mappedValues(expr string, convert func(string) string) string {
+	if body := convert("$0"); body != "$0" {
+		return expr + ".mapValues { " + body + " }"
+	}
+	return expr
+}
@@ -0,0 +1,226 @@
+package swift
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/chakrit/rpc/generator/tmpldata"
+	"github.com/chakrit/rpc/spec"
+)
+
+const (
+	RpcTemplateName  = "/swift/Rpc.swift.gotmpl"
+	UtilTemplateName = "/swift/RpcUtil.swift.gotmpl"
+	RpcOutName       = "Rpc.swift"
+	UtilOutName      = "RpcUtil.swift"
+)
+
+type (
+	// Resolution is how a type reference is written in Swift, along with the Codable type
+	// it is sent as. Wire types other than the plain ones are wrappers from RpcUtil.swift,
+	// and Default is the value used when the JSON holds null, as the Go code sends for
+	// empty lists, maps and data.
+	Resolution struct {
+		Name    string
+		Wire    string
+		Default string
+		Inner   *Resolution // the wrapped type of optionals
+
+		toWire   func(expr string) string
+		fromWire func(expr string) string
+	}
+
+	Field struct {
+		Name     string
+		JSONName string
+		Doc      string
+		Type     *Resolution
+
+		Annotations spec.Annotations
+	}
+
+	// Type is a type declaration, or the details of an error declaration.
+	Type struct {
+		Name   string
+		Doc    string
+		Code   string
+		Fields []*Field
+
+		Annotations spec.Annotations
+	}
+
+	Member struct {
+		Name    string
+		Doc     string
+		Literal string
+	}
+
+	// Enum is an enum declaration. Fallback is the case that unknown values are decoded
+	// into, given with `@fallback`, if any.
+	Enum struct {
+		Name     string
+		Doc      string
+		RawType  string
+		Fallback string
+		Members  []*Member
+
+		Annotations spec.Annotations
+	}
+
+	Arg struct {
+		Name string
+		Type *Resolution
+	}
+
+	// Thrown is an error an rpc declares with `throws`, thrown as a ThrownError with
+	// details of the given type.
+	Thrown struct {
+		Code string
+		Type string
+	}
+
+	RpcFunc struct {
+		Name    string
+		Doc     string
+		RPCPath string
+
+		Args    []*Arg
+		Returns []*Resolution
+		Errors  []*Thrown
+
+		Annotations spec.Annotations
+	}
+
+	// File is the data for the Rpc.swift template. Declarations holds the nested
+	// namespaces already rendered, while clients are rendered from the flattened list of
+	// modules.
+	File struct {
+		Root         *Module
+		Declarations string
+		Modules      []*Module
+	}
+)
+
+// ToWire returns the Swift expression converting expr to the wire type.
+func (r *Resolution) ToWire(expr string) string {
+	if r.toWire == nil {
+		return expr
+	}
+	return r.toWire(expr)
+}
+
+// FromWire returns the Swift expression converting expr from the wire type.
+func (r *Resolution) FromWire(expr string) string {
+	if r.fromWire == nil {
+		return expr
+	}
+	return r.fromWire(expr)
+}
+
+// Decode returns the Swift expression decoding the value of the given key from a keyed
+// container named `container`, where null and missing keys decode to nil or the default.
+func (r *Resolution) Decode(key string) string {
+	switch {
+	case r.Inner != nil:
+		return mapped("try container.decodeIfPresent("+r.Inner.Wire+".self, forKey: ."+key+")", r.Inner.FromWire)
+	case r.Default != "":
+		return mapped("try container.decodeIfPresent("+r.Wire+".self, forKey: ."+key+")", r.FromWire) + " ?? " + r.Default
+	default:
+		return r.FromWire("try container.decode(" + r.Wire + ".self, forKey: ." + key + ")")
+	}
+}
+
+// Return returns the Swift expression decoding the next return value from an RpcReturns
+// named `returns`.
+func (r *Resolution) Return() string {
+	return r.FromWire("try returns.decode(" + r.Wire + ".self)")
+}
+
+func Generate(ns *spec.Namespace, outdir string, templates tmpldata.Overlay) error {
+	root := newRootModule(ns)
+
+	tmpl, err := parse(templates, RpcTemplateName)
+	if err != nil {
+		return fmt.Errorf("swift template failure: %w", err)
+	}
+	declarations, err := renderDeclarations(tmpl, root)
+	if err != nil {
+		return fmt.Errorf("swift template failure: %w", err)
+	}
+
+	file := &File{Root: root, Declarations: declarations, Modules: root.flatten()}
+	if err := write(filepath.Join(outdir, RpcOutName), tmpl, file); err != nil {
+		return fmt.Errorf("swift template failure: %w", err)
+	}
+
+	utilTmpl, err := parse(templates, UtilTemplateName)
+	if err != nil {
+		return fmt.Errorf("swift template failure: %w", err)
+	}
+	if err := write(filepath.Join(outdir, UtilOutName), utilTmpl, file); err != nil {
+		return fmt.Errorf("swift template failure: %w", err)
+	}
+	return nil
+}
+
+func parse(templates tmpldata.Overlay, tmplname string) (*template.Template, error) {
+	tmplContent, err := templates.Read(tmplname)
+	if err != nil {
+		return nil, err
+	}
+	return template.New(tmplname).Funcs(funcMap()).Parse(tmplContent)
+}
+
+// renderDeclarations renders the types of the module followed by its children, each
+// wrapped in an indented caseless enum so names resolve the same way as in the spec,
+// innermost namespace first.
+func renderDeclarations(tmpl *template.Template, mod *Module) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.ExecuteTemplate(buf, "declarations", mod); err != nil {
+		return "", err
+	}
+
+	for _, child := range mod.Children {
+		body, err := renderDeclarations(tmpl, child)
+		if err != nil {
+			return "", err
+		}
+
+		buf.WriteString("\n" + doc("", child.Namespace.Doc))
+		buf.WriteString("public enum " + child.Name + " {\n")
+		for _, line := range strings.Split(strings.TrimSpace(body), "\n") {
+			if line != "" {
+				buf.WriteString("    " + line)
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.String(), nil
+}
+
+var (
+	trailingSpaces  = regexp.MustCompile(`(?m)[ \t]+$`)
+	extraBlankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+func write(outpath string, tmpl *template.Template, data interface{}) error {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outpath), 0755); err != nil {
+		return err
+	}
+	content := trailingSpaces.ReplaceAll(buf.Bytes(), nil)
+	content = extraBlankLines.ReplaceAll(content, []byte("\n\n"))
+	content = append(bytes.TrimRight(content, "\n"), '\n')
+	return ioutil.WriteFile(outpath, content, 0644)
+}
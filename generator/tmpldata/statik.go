@@ -8,6 +8,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00'Q9O\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00elm/Rpc.elm.gotmplUT\x05\x00\x01\xcb<\x8b]\xccY_o\xdb6\x10\x7f\xd7\xa78\x18}\x90P[I\xdf\x86`1\xd6\xc6	\xdabm\x834\xddK;\x14\xb4D;l\xf4\xaf\x14\xd5\xc5p\xf2\xdd\x07R$E\x8a\xa4\xe3d\xe96=$\"\x8f<\xde\xefwG\x1eO.\xeb\xbc+0l\xb7\x90\xbeG%\x86\xbb;\xc07M\xdd\x92j\x0dq\x9a&Q4\x9b\xc1\xaf\xa8c\xf5l\x8d+L\x11\xc39\x1c\xccy\xefoC\xc7r\x03k\xc2\xae\xbae\x9a\xd5\xe5Av\x85\xae)a\x07\xb4\xc9\xa2\x88\x94MM\x19\xbcf\xacQ\xefo\xdb\xbaJ\x178\xabs\x0c\xa8\x85\x85\xd5\x7fZ\xa9\xfeS\xd5\xbf \x193\xac\xe2\xcdD\xc9.Q{m\xc8xs\x90\x91\x12\x1b\xb2\xf3\xba%7Z\xf8j\xc3pkHE\xdb\x96J[T\xdfE\x93}b\xa40\xe6\x9c\xd4\xd5\x8a\xac\xa7\\rJiM\xc5\xdb\x05n\xbb\x82M!\x17\x00_6M\xb1\x99\xc2\x8a\xd6%\xa7\xa0\x17&\xd1v;\x03\x8a\xaa5\x86gR\xfb\xd11\xa4o\xc4k\x0bpw\xa7\x16\xddn\xd5\x08\xe5\x1f1\x17W\xb9\x18\x15E\xdb-(Ml\xd3`\xa1\xe7r\xd3\xe0^\x8b\xe8B\x05A\xad\xd0\xc4\x9b\xda\xcf\xc7\x11\x00\x80iI~3\x85g+\x82\x8b\x9c\xab\xe9G\x9f\xf1f\xaf\xac\x1f\x0eduFh\xcb\xc4x\x98l'0\x99N\xb8>\xbe\x80\x98\xacW8\xe2}1\xc5m]\xfc\xc0J\xc8\x8dK4\x18e\x82\x02\xc4\xdbwQ\x94\xe3\x15\xea\n\xe6\xd8|\xe4\xc0\x08\x0e\xfd\xf9\xf0\x8e\xc3\xf0\x16\xbdQc\x84\x03@,Bk\x0f|0\x9b\xc3i\xfa\x07*:\x1c\x9aT/\xbfI\xb4\xa7i\xbd\xfc\x863&\x1a\x8f\x80\xef\xa5\xe0\xb3\xa6 \x86\x89\xc3\xc2d\x1afA\xee\xe5\xde\xc4\xd4%0\xb1\xec4\x08\xe2\xcf\x9f<\n\x02$-\xe4\xf1A}\xe1\xe0\x9d\xa3\xa2\x01\x80\xac \xc6\xdf!.pe\x91\x90\xc0a\x023\xc3\x80E\xdavY\x86q\x0e[\x1d\x1c\x80\x8b\x16\xefP\xf1\xc2Va2\x13\x93*\xc776\xef\x87\x89\x8e\x17\xc9\x94\x9e\xca\x9f\xdb9,\xd2\xdec\x9c\xf8\x80\x06\xe5\x08wj\x896K\xec\xebn ~\xc7e\xe9_\x84]\xa9X\x8d\x1f`\xad\x8a\xee$\xf1\xabw\xdc\x02c\xfe\n\xec\xe3\xef\x97\xb1\x0bJ\xd4l\xb70\x1e\xa8\xf6\xa3\xb3\x84z\x1e\x11\xf7\xea\x89wlj\x9f\x93\xd43r\xd6h\x97\x84\xa7\xb8N\x1a\x89\xefwV\xe0\xe8\x199\x87?\x89\xc3\x92y\xf0j\x07\x05\xb6\xc1O`\xfcv\xfe(\xc2\x1fH\xf6\x0e\xa2\x7f\x02\xc9\xb7s3\xf9\xefA\xb9l\x9b\xeff^\xc7UW\x8a\xbc~Zu\xa5\x91\xd79p.s\x92\xa9\xe5\x87\x12\x97KL\x85#\xc4\xe0w\xa2\xbd#\xe5\x1dO`r\xabS^?=\x98\xaf#T\x14c;\xe0\x08~'-s\xed\xf3\x8d\x0d\xa4\xe9\x07[\xfd\xd9J\xd4\xf7X\x0d}~i\x10\xa1\xed\x87U\xc8\xfe\x18>2J\xaa\xf5\xd4A\x02Ip\xee\xd3\xe3\x91YWB\x127\x01\x9dwG@\xe5\xfe\xf6@e\x84\x15\xf8|_\xbc\xfd\x7fHvO\xfb\x17\xa1\x9a\xdd\x97\xdc&\xde\x1dF\xdb\n\xfb/k\x0fR	m6\x07\xb1\xdf\xdd \x0d\xcem\x19\x95\x903\xd4b\xd1\xacW\x8f\xa3\x80?^\xa40\x9b[\xc7\xc5\xdbN\xee\xa3Q@{\x81\xf3\xe7\xebX\xc3\xfb\x9a]\x91j\xad89\xa3u\xe9a\xc5\xe9\x9a\xcd%S\xbb\xe6\xfd0\xf9\xf8\xf1\x8f\xd8\xf0\xc4\xf2\x08\x88\x97/\xf7<\x12\x11\xfb\xf1\x91X\xef\x9b\xfc\x9f\x01\xd61\xef\x026\xea\x1f\xef\xee\xd4W9\xcb\x94\xe1\xdeh\xd6\"{0\xe5\xd6\"\x9eUw\xc4\xcc\x9c\xab\xe8\xe5\xe6\x05\x7f\xb4\xb2}\xc1\xb7\xf6g`N\xbf\xf0B\xa9V$\xea\xf4\x1e\xda\xd6\xeeH\xcfE \xc0q\x12L\xd9\xack\nY\x8b\xf37\x7f1\xce%\xae\xbb\xecPBt\xdd\xdf\xa1\xc4\xe0\x97t\xdd\xea\xcb\x99s\x8e\xc6\xfc\x1c\x05~\x90Z\xf7\x16D\xd7\x9ez\xdb\xbc\xe6\xc5\xb1}\x92\xaa\xfe\xc4\xaaS-s\x8f<\x10\xfc\x95\xaa9\xe4\x890r\x88\x88\xae\xb9z\xde\x19\x84\xe5\x07%\xa9\x06Q/\x17\"\xf1\x91\x1cW\x8c0\xe7\xae\xb6\xbf\xa1\xc6\x9c\x1d\xf7\x92\x91S\x86\xfa\xd8Ec\x1a\xe1\xdc\xc9\xf9\xf3\xd9\x1df\xa0\x04\xa7\x82\xd6~\xf0U\xd0\x96\x93|\x93\xec|o\x17\xc0\x9a\x0dQB\x9b\x00\x86\xda\xc1\x8c0\xb7\x8464\xbcHF\xa7\xa4[\x92\x0e\xec\x1f&;K\xe7~\xc2\xa1+x\x8a\xc2xl\xc5}%q\xfc\x05\xf1\x1d\x12\xa3dD\x85M\x98Y\xf0\x0eK\xf0\xbb\x91A\xca(\xdf|}pT~\xf92\x81	\xf8\xf7\x11\xd8\x19\xc6LK\x0f_\xd9\xbb:\x0c'\xd5\xee\xe5MmI\x126\xc3\xb31\xc7\x01\x11\xbb\xdb\xef\x9e\xf2\xb2wt`[\xc2SU\x95\xd2\x92@\xf4\xb8_\xc8\xccS\xf4\xbe\xc2\x916\x99\xc8A\x17\xe7'g]\x95\xf5\xa4d\xb2\n\xa3M\xa6v\xb6\xf8\x88~\x04\xfd\xe7l\x1e\xa4o\xaa\xa6cg5\x1d\x8d\xe3\"1V}\xf0\x86\x0f\x1d\xf3\x8e\x0c\xae\x92\xf5k\x10\xbe\x80<P\n<|\xaf\\\xd6\xf9\xc68\x9f\xf9\xf3\x9a\xb1&\xfd\xd6\xd6\xd5+.\x8b\xfb\xcc\x122P\xe8M\"\xad@\xd2LG:\xe5\xa7\xfcT\x8b\xfb#/\x88\x86\xcf!U\xa4\xcda\xa8\xbd\x1e<\x03%fWu\x0e\xc709\xff\xf0\xf1r\xf8\xfc0\x85+\x8cr~\xdd:\x96\xc0S\xd9a\x0c\xe9h1\x88\x97\xa8\xc5\x9fh\x01\xcf\x9f\xc3\xe4@Yqq~r\x8e\xd8\x95\xf5ec*\xa9\x12\xff\x8c\xde\x01\xb0~5\xa4\x8c\x94\xb8\xe6\xc4\xeb\xb2@\xc9\xee\xa2\xc8\xe7\xb3}\xa3\"\xd6?|\x04C\x82\x0fC	\xff{R\xe6\x80\xbc\xabY\xd1Q\"\xf9sI0N\x1e\x15\x1bJ\x05\xbeip\xc6\x94\x92\xbe\xf5\xb6\xad+\x88\xed\x1fk\xf8\x8dU\x9b\x92\x08\xa4\"vr\x99?w\x87N\xe2\xc4\x0e\xc5\xdf;\xdc\xb2\xffg\xf8hR\xfa\x97\xbdBg\n\x8c\xa2\xecZ\xc4\x9c\x1bV\xe6\xd9\xf4w\x00\x00\x00\xff\xffPK\x07\x08\xd7V\xda_\xdc\x05\x00\x00m\x1c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x12H8O\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00elm/RpcUtil.elm.gotmplUT\x05\x00\x014\xdb\x89]\xc4W_o\xdb6\x10\x7f\xe7\xa78\xe8IBkw\xdb\xa3\xd1\x06K\x9a`]\xd04\x80\x93\xec\xa9@@K\xe7X\xb5$\n$\x95\xccX\xf7\xdd\x07\xfe\x15)\xd1\x89\xdf\xc6\x87D\xf7\x97w?\x1e\xef\xe8\x96UC\x83\xb0\xee\xcb\x07Y7\x80\x7f\xf7L\xd4\xdd\x13\x01\x00\xc8\xe13\xeb\xb6\xb5!\xde+\x9d+\xce\x19\xcf\x97\xcbbd\xadQ\x0c\x8d\xb4t\xa9\xf5/\xb1d\x15r\xcb\xab4u\xde\xf7\xcd!\xe2\xdcI\xee6r\xac\xbfh3`\xc4qNPm|\xcf\"\x9b-g\xed\x17)\xfb(\x82\x96\xf6\xf6\x8b\xa3`\xcd\xb3\xf5P\x10\xb2X\xc0G:H\xb6x\xc2\x0e9\x95X\xc1\x873\xc5\xfd}dl\x0e\xf0T\xcb\xdd\xb0Y\x96\xac\xfdP\xee\xe8\x9e\xd7\xf2\x03\xefKB\xea\xb6g\\\xc29\xe7\xf4\xe0a\x82\\\xd3\x85\x93^\xd4\xf2\xa5\x16\x08T\xa8O\xe1\xd9\x07\x89\"0\xd2t\x11I\x97\x064m\xa9\xe8K,c\xf9U\x17\xc9\xaf:/\xbf\xacK\x198W\xa4\xf7\xad\xf0	d\xfe\xfc\xde\xc3\xda\xc2\xa3\xbfz\xd6	T|ox-X\x17\xc4\xa4H\x15\x12!\xf2\xd0#\xd0\xa6\xa6\xc2V\x07|\xd2\x08\xff\x03\x1b*\xf0\x817\xb0\x82\xe8\x98vH+\xe4\x02V\xf0\xb5\x16&\xa2\xe5\x17\xcd\xd3\n\xff\x12B\xa2\xb2\x81\x95\xdbm\xe98\xb6\x0cc5\xb3m\x83\xe6\xe4\xd5jio\x1c\xc3\xca\x9e\x93	\x04\x16g\xb3mc}\xca\x9d?\xb7B\xbfn\x99L&\x8an\xe9\x0d\x97O(\xe1\x17\xed\xee\xe7\x19\xdc\xd0\xc3\x06\x97/\xb5\xdc]\xe2\x96\x0e\x8d\x84,#3\xe3\x92u\x12;\xf9\xa6\xdb__u\x1b\xda\xd4]D\xea\xd4m\xec\xf6\x9f\xdd\x93\xcc\xa10\xb89\xfc\xf2\x10\xc6B\xe1\x98<\xc3\x84\x879\xa26z\x93PK\xfb\x00~\xcf\x96L\xf9'A\x12\xae\x14Z\xda\xff\x16\xb6#\xb5r'\xdc\xd6\xd8T\x90\xd9\x02\xcc\xe0\xe3Oo'L\xe8GmluF6Qp\x16\x08'\xa3)r\xb2YQ\x10B\x82\xb6\x97\xa8h\xaa\xb0\x9c2s\xcd\xdd\x14)\xd9&r\xa8\x83\xbf\xc4\x12z\xcaeM\x1b\x0b\xb5\x8f\xaa\xab\xeew\xd8A\xfe\xbd\x0f}\xa9\xbczo[8cw\xa9]\x7f\xd7\xae>\xe9C\xd6\xb49\xeeQd\x802\"\xe7:\x94\x9e\xf7\xb5\x11\xda6\x10\xf5\x0c?1\x80\xda\xa0-\xe9v\x07J\x08\x89[;\xac\x9c\xd2\x18	\xe4\x81'\x8dX@O\xedw\xe3\xa7\xd9\xb3\xa4\x02C.\xdb\xfa\x02\xb9\xe2\\M\x1bX\x9cE\xd5\xab\xd8\xf9\x08	r^\x8c\xb7\xe7v\xaf\xa6\x8dr4\xb1Z,`\xe8^8\xed\xa1\xee:\xe4V+R\xb1,B\xa2\x11\xa7Rv\x80,\xce\x1c\x92\xb1\xca\xceD3MI\xb1\x82|\xc6\x98\xf3\x0bZ\xa9\xfe,$/\xa6\x81f\x17\xb4\x82\x87\xf5\xd7\x15d\xf0\xee\x9dR!	\x0f\xf7u\x8bl\x98e\x99}C\xf9\xc2\xf8\xde\xc9\xb3\x94\xad\xd5q)\xa5\x1dhi\xd2\\\x05\x7f'\xa9\x1c\x04\xa8k\x90N\xc0*|f\x15\xdaD\x0cTKU\x0f\x7fvR\x9b\x1e\xf3~\xc1\xaaC\x1a\x9b\x1b\xdal\x19o\xb1\xf2\xa32\x05\xd3x)\x12\xe5\x93]\xdf\xdd~3\xe9Y[ws\xe23E\x1ex\xf4\x17I\xc8\x99C\xbd5qo\x9cc\x1d\xc6\x0d\xf9\xf0vy\x1b\xfb\xc2z\xb4\x05\xa4\xef\x96\xe9`\xeb\xf0\xe9\xa4V\xfe\x9d\xa3\xe8\xa71\xe8\x92\xd3\x82\xa0\xde\xdc2\xc5\xf6\x08bj\xe5\xd6\xe4F\xf9\xea,\x8a\xf9\x8c\xb4\x85\xf5x\xa2/\xab\x9ep\x14V\xe1\xa9\xdeB\x9b\x84K_\x98\x8f\xd0\xa2\xa4\x15\x95\x14Nu\x1dT\xb5\xb3]\nM\xab\x1aN!\xf1\x07c\xf3\xed\x12\xf5\xe1V\xf8\xd6\x86\xdc\x1e\xb9?\xfaB\xd7\x91\xd3UcK\xcd\x88\x95n\xae\xbe\xf9\xb4\xc2\x8c~3\x9e\x1c\x156r\xc3\xd6\xb6\xc8\xf9\x0d\xed\x81\xed\xd5_>\xeb\xb9\xfc\xd4~k\xfdD\xf7\xe1v\x0fl\xf3c\xaai\xb6b\x9b\x1f~\xe8\xfa\x16:\x1b\xb0\xd3\x991\xbe\x0f\xc3\xe9\x11y\xf1\xb7D\x81\x1cd\xe2|\x1fW\x0e\x92\xbc\xdd\xc3\xf3[\xb3a=\x9f\x0d\xcf\xe4-\x98t=E\x9dg|{\xe8_S\xa7\xa0\xe0\x14\x8cA\x12\x0c#\xf2\xe9=k\xf2(\x1aI\xed\xff\x1b\x8e\xa3M\xf2U|\xbc1G9\xf0\xee\xd5\x1f\x1f\xf7\xcc\xbfW\xf4\x1b=(/:\x855e\xa6\x12\xe28\xfd%\xa0\xf1\xc5x\xa8\xbbu=\x08\xf9\xda\xed\xd7x\x84c$\xd5\x12\x99\xdc\x99(\x93.\xf4\xdb\xe6\xf8\x93<\x88\xdf\x9bO\xdf\xd8z\xc4e#\xbb\xd5\xe0L\x1f\xcdG\xcd\x0d\xf2\"\x8b\x8f\xa0 \xe4\xbf\x00\x00\x00\xff\xffPK\x07\x08\x84\x1a\xbb2|\x04\x00\x00\xbc\x10\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x12H8O\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00golang/client.go.gotmplUT\x05\x00\x014\xdb\x89]\xb4WMo\xe36\x13>\xaf~\xc5\xbcB\x10H^\x87\xba\xfb\xad\x8b6\xce\x02\xcd\xa1\x89\xe1\x0d\xd0\xc3b\x910\xd4\xd8V#S\nI%q\x05\xfd\xf7\x82_\xfa\x8a\x1d/\xd0\x96@\x02\x91\x1c\xce\xcc\xf3pf8\xae\xeb\x0b8cy\x86\\-\x9f60\x9b\x03Y\x14\\\xe1\x9b\x99^4M`$DQ\xb4\xfbWTQ\xbf\x99$\xf0\x13\xadTq\xb1A\x8e\x82*L!\xf9Y\xaf\xfe\xd2-<\xeea\x93\xa9m\xf5HX\xb1K\xd8\x96>\x89L%\xa2dA\x92hQ|+\x91i\xc1lW\x16B\xcd\xa0\xae[\x83\xe4\xda\xac-\xa9\xdaB\xd3$\xd6\xd1\xa0\xa4\xec\x89n\x10\xdc4\x08\xecI\x88\x02\x00\x80\xf0q\xafP\x86\xf6\x9bY0n\x86\x9c\x15i\xc67\xc9\x9f\xb2\xe0n\x8d\xa3J\xb6J\x95n\xaa\xb2\x1d\xda\xcf\xba\x06\x85\xbb2\xa7\n!\xb4\x16d\xd8Q\xd14A\x1c\x04/T8\xb3\xf7\xe0ly\x02a\x0e<\xcb\xdd\x9eVK\xee\xb2\x1d\xc2\xbc\xfb\xae\x8d\nMp\x8a\xeb\x8c#\x84\xa2d\xf7\x02\x19f/(B\xc3\xb0\xf3\xe4\xf8\x1d\xf5d\xca\xd1\x0d5M`\xf6\x92\xc4o\x0f\xf94\x9b\x1a\xc1}\xbb\xff;\xe5\x9b\x1c\xd3\x1b\xbaCh\x1ar\xcd\x15\x8a5e\xda\xed\x85\xf1\xe0\xfe\xb0d\xedL\xa9}\x89\x1fK\x82T\xa2b\nj#\xaf\xc7\xc4\xca{\x18 (\xdf \x9c\xb1m\x96\xa7\x1a\x8fQ\xb2\xd03\x81\xbc%\xc5I\x97T2\x9a;i\xe2m\xf4<\xb0\x1bC\x1fZS\xc8\xd3V\xa1C\xb0\xae8\x83\x88y\xaf\x8e\xa0\x88!\xe3\x99\xcah\x9e\xfd\x85\x91\xbd\x1b\x7f\"\xeeAc\xc4\xae\xc1\xdc\x07k\xe7\xfa\xc5	\xa0\xd0\x03ju\x1d\x85;?\x05\xb8\xfeQU\xe4\x1d\xacx\xe0\xb2&\x0c\x86\x84\xf5\xaeL\x94\xac\xc5\xa1\x15\xca\x922$\xab\xe5B\x92\xaf\x85P\x98^\xee\x8d\x9d\xfe\x1dz\xbeO\xd0m\x8aB\xc9\xbc\x9f\xd1\x10\x8fz\x1bg\xdf\xb4\x1f$\xde\xbf\x8c\xa7\xf86\x853*l\xa2\\\xf3\xb2Rw\xfb\x12\xe5\xc0%=\xa8\xd8h\x93\xe6\x84\xa6\xb8\xae\x81\xca\x15\xaeQ g\xd8\xcf\xc7H\xa0,\xf2\x17\xb4\xf9\xa7u\xc7\xd04C\xfb\xfd8\xd3#\x86\xe8G\xfc\xbb\xad\xd4Q\x07\x8bJ\xfd\x87\x0e\xea\x81B\xe8\xbfBL{~\xd7\x03\x91\x92\xee\xf3\x82\x9a\xe0\xfd\xf6=\xf3\xc5\xa2n\x86R\xbdX\xf7\x08\xefO\xf1\x0f\xef\xef`\xfaN\xe9!\xaf]T\xfa\xf1X\xad\xb5\xa9s\xf3(\x90\xcbj\xbdF1\xca\x86lm\xa0\xceA\xbf\n\xe4\x06_\xbf\xe8g\x02E\xf4X\xadcb'\x91C\x1a\xff\xdf\xc8\xfe\xcf\xd4\xf6\x11\x19z\x08T\x95\xe0\x1f9\xa4\xeb\xad\xc0g\x98\xe8G\x87\xac\xf0\xb9B\xa9\x82\xa1\x8e\xe7\xa9\xf3\xc8\xc8\xdc\xe0\xab\x13\x8b\xc2\xe5\xed\xd7\xbbp\n\xa1\xde\x98%I\x08\x9f\xdb\x1aCnK\x95\x15\\\x92_\xd3T\xc0g\x08\x13\x9fK\xab\xe5\xc2\xbf\xa0\xa34\n\xa7\x9a\xa0\xf8\x10\x1d\xff\x00\xa2\x867\xd7\xff\xc9\x1f\x99\xda\xba\x84\x8c\x98z\x8b\x0fQ!\xcb\x96\x0bY\x16\\\xe2H\x97,=\x1b\x8c\xfcvw\xb7th\xaf\x8aH\xe0\xf3\xbf\xef\xba\x96\x90&\xa0\xeb\x1ar\xe4\xc3,l\x9a\xc3a~4\xc4?Ja=\xceGy<\x1d\xa8<\x1c\xe0\xfd\x99@Y\xe5\xca\x84\xf8\xca|\xd6\x87\xf6\xc9\xca\xe1\x9a{\x84\xdff\xdf\x831w\x9akrY\xa4\xfb\xe3\x0c\xa6\xba\xc0t\x82d\x91\x17\x12\xa3\xd1\xbd\xc2\xa1\xa4\xbaB\x9bT\xed\xd9\x98\xd8\xa5\xc8\xbax*\xb3\xe0\xf0\xfd\xc1;F\x9aC\xb84\x07_t-;n\xc0\xba\xdb\x97\xfd\x90\xf6\x81'\xef;\n\xb7p\xea\x91\x1fu3]\xc39\xec\x04#\xf7\xba\xf5\xcb\xbaU\x19w\xedL\x17.\xbd\xef\xbaN&\xd0W\x06\x93D\xbb\xf7\x811b\x1aG\xd3\xc9\xd9\x90\xeaZ\xb6O\x96C\xb0\xefB\xcb\xc6\x83\xbe\xe4Yh\x16\xc3\x87\xe0\x93\x8f\xb6\xc1\x9b\xe0\xa5\\\x00\x86\x0f\x81\xb7\xe2z\xa4Ac\xe8\x8a\x99\xf9\xee\xb5\x06\xfew\xc1\xa8\x9f3b]qp\x05\xc5\xf5\x95\xde\x8cS9\xb4c\x8a\xa5T\"\xe3\x1b-h\xba\x91\x1b|\x8d\x8aRI\x98\xb8#\xb1\xef\xed\xdc!\xd7\xf0\xe9\xa4\xb3\xeb]4\xb9\x133\x98h\x0d]6\x9f\xc40;-R\xf7\xaaC\x07v\x06\xe7=\xb4^\xa6\xe99JNj>\xd6\xf6\xd9\xbb\xf2\xbdk\x13\xfc\x1d\x00\x00\xff\xffPK\x07\x08i\x93QmF\x04\x00\x00/\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x12H8O\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00golang/pkg.go.gotmplUT\x05\x00\x014\xdb\x89]\xd4\x95\xcfo\x9bJ\x10\xc7\xef\xfc\x15\xf3P\xf4\x04O6\xdc\xfd\xea\xaaR\xd4C*%\xb1\xd2\xf4TU\xcd\xb2\x8c1\xb1YV\xc3\x12\xc5Z\xf1\xbfW\xfb\xc36`;i\xa3\xf6\xd0\x1cb\x98\x1d\x86\xcf|\xbf\xcbl\x9a\xc2;\xd6\xaazZ\xa0@b\nsH\xdf\x07i\n\x1f\x0e\x81l\x0bE\xa9Vm\x96\xf0\xbaJ\xf9\x8a\xad\xa9T)I\x1e\xa4\xa9I\xc5g\x89\xdc$\x96\x95\xacI\xcd@kH\xae\xec\xf5\x82\xa9\x15t] \x19_\xb3\x02\xed\xca\x0d\xab\xd0\xc4\x02\xad\xe1B\xae\x0b\x98\xcd!\xb1\x01\xf7<D\x01\x00@\xc8k\xa1\xf0Y\x85\xee\x0e\x05\xaf\xf3R\x14\xe9cS\x0b\x1f\xab\x98Z\x85\x81\xbd\xd6\x1a\x80\x98(\x10.r\x94\xb6\xa4#h`\xdau>e\n\xe5\x12\x92^\x00\x92k&\x8a\x0d\xe6\x9e	\xc2#\xf4p_\x1eE>\xa8e\xee\x0dv\x1c\x04O\x8c<\xf5w\xf0\xd8\xc9\xa5\xfb\x859\x88r\xe3\xd7\xe6`\xe8\x93kF\xcd\x8a\x1d\x82\xa6\x8fdQ\x9aJZ\xef\xda\x10\xac\xc2	\\\xa8\xadD\xdb\x8eAl$\xe3\x98\xdco%6\xe6\xcdv\xcd\xa8(<~\xa3\xa8\xe5\n\xf4X\x12_KR\xed\xa4YP-\x91T\x89}u@\xb2\x86\xb3\xcd\xa1\x9a\xd6\xc0\x9a;\\\"\xa1\xe0\xe8\xbc\x8a\x08\x9bz\xf3\xe4\xeflE\xcb\x13\x9b\x07\x1eLw\xb3\xb0G\x14\xc2\x96U\x9bQ(\xcfl\xa0\x11l\xed\xd9\xba.|8\xd2\xb9\x0b\x82e+8Du\xf6\x08\xffi\xed3c\xf0\xf2}\xfa|{\x13\xc5\x10}\xfd\x96m\x15N\x00\x89j\x8a}\xf3u\xab\xccc\xb3\xb9\xd7\xc4E\x7f]\x96\x97\xa4\xf1\x1c\xf7\x8c\nTo\x93\xe7a\x80\xd5\xdfa\xdd\xef%\x9e\x0d\x90\x91^\xc75\xb2'\xc7\x85\xe2\xc9yd\xfb\x9fP\xb5$\x06\x1b=rn\xc4\xe7-\xfd\"\xaa\x9e\xa9Y\xbb\x04\xe7j\xec\\\xf5\xa6\x96\xe2o\xf7\xb4s\xd3\xaa\\\x9a\xbe\x0c\x9f\x95i\xdf\xbd\xe9|\x02\xff\xda>\xe3\xffm\xce?v~\xc0\xa1W\xaf/\x12y\xd1\xdf\xf0\xb1\x9f\xb6\x16\xe6N\x83=\xceOm\x13\x0b{j\xa3\x9c\x1c\x9c\x9e\xde\x8c\xc4\xce\x0c;\xb3\xe6\xcf\x82!>\x8a\xb6\x1a\xcd\xbd\x8f\xa2\xad\xce\xce\xbdR\x14A\xc0k\xd1\xec\xce\x8f\x9e\"\x15V\x19Z\xb9m\xd9\xe4\xda\xde\x0ff\xdf\xae\x94\xb9\xf4\xe9;AvK\x91q9g\xcd\xaa\x97\x11\x1ew\x19\xf7\xdb\xb2\xa4WB!-\x19G(\xf7W\xe7F4I>\xea\xfanqy\x924\x1a\x1d4\xfd\xefr:\xaa\xca\xc8\x9d\xb2WB\xb6\xca\x1d\x1f\xa3\x8f\xe1\xb5A\xcf\xa80n\x0f_\xd276\xf6\xb2\xbf\x0cp\xdb\xaa?F`\xfe\xec\xb4p){g\xbc\x17]\xf0#\x00\x00\xff\xffPK\x07\x08\x9b\xd1\xf2Y\xa9\x02\x00\x00\xec\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x12H8O\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00golang/server.go.gotmplUT\x05\x00\x014\xdb\x89]\xb4X[o\xdb\xb8\x12~\xd7\xaf\x98#\x14\x81\x14(rpp\xce\x8b\xcf\xf1b\xbbi\x8b\x16\xe8%H\x0b\xf4\xa1(\x12E\x1a\xdb\xda\xc8\x94BR\xa9\x03A\xff}1\xbc\xc8\xd4\xc5v\xb2\xed\xea!\x0e\xc9\x99o\x86s\xe3\x90Ms\x06/\x04\xf2\x07\xe4\x97w+\x98/ \xbe(\x99\xc4\xad\xa4\xe1Y\xdbz\x8a\x82\x97\xa5\xb4\xeb\xaf\x12\x99\xd8\xc5\xd9\x0c\xfe\x9f\xd4\xb2<[!C\x9eH\xcc`\xf6\x1b\xcd\xfe\xbe\x9b\xb8}\x84U.\xd7\xf5m\x9c\x96\x9bY\xbaN\xeex.g\xbcJ\xbd\xd9\x8cHq[aJ\x84\xf9\xa6*\xb9\x9cC\xd3t\x02\xe3wj\xee2\x91kh\xdb\x99V\xd4\xab\x92\xf4.Y!\x98\xa1\xa7\x19!\xf0\x00\x00\xfcT\xeb\xef\xeb\x11\xb2\xb4\xccr\xb6\x9a\xfd)Jf\xe6\x18\xca\xd9Z\xca\xca\xf7\xd4\xb8i@\xe2\xa6*\x12\x89\xe0k,\xe1\xef\xf6\xdc\xb6^\xe8y^\xd3\x00d\xb8\xcc\x19\x82_\xf1\xf2!\xcf\x90\x7fy\xac\xd0W\x8608Gl\xd9QU\x13\xb6\xa4E\xf9X!\\\x1a\xf4k2Du\xb7\x8a?$lU`\xf61\xd9 \xb4-\xe4L\"_&)B\xa3\x98\xe83<{X\x82\x10\xa6\x17\xe2w\x16\xab3\x05\xf0\x84\xad\x10^\xa4\xeb\xbc\xc8HI\xc5vA#\x8e\xac\xd3\xd4\x11\xaa\x15U\xf4\x03\xf8\x0e\x13Y\xd6q\xb6\x7fGT\xcfG}\xf3\x07\xc6\xe1\xae\xed5d8\xa5\x02\xc5\xb3\xfd\xdf;\x00\x1b\x13\xb3\xa7\x1cr\x85\xa2.$\x08\xc9\xebT\x1a\xa3\xbf\xe6\xbc\xe4\x00\x80\xe6W\x7f7\x14es_M\xfa7\x8a\xf0\ne\xcd\x99\x80o\xdf;\xbf5\xad%\xe4z\xd1\xbf\xf1\xac\xac\xcfj\x0f}Ye%\xf3\x92	\xf8\xa4\x7f=\xd7\xf6}'\xd8\xb4\x19\xb8\xc1\x82\x1b\x80>\xfa\xcb,3\x1b\x10\x92\xe7l\xa5&/\xe4\xf6M^H\xe4\xb0\xacY\x1ap\xbc\x87SJ\x99\xf8\n\xefk\x142\x82\x0d\xcau\x99\x19\x9e\x10\x8c\x13l\xc4[\x1b=\x07$\"cj\x83\x86\xfa\xc7\xa2\xbc/W\xf4\xdf\xb3Q\x14\xff\x9b\x92o\x12\xf9\x9a\x1b-\x1c\x19f\xbf\xad\xe7\xd1\n|\xc4\x1fAYI\x01\xa7\xc6N!\x9c\x1awhK	\xfe@Qz\xa2'\x1b\xe3\x969\x9c\x12\x97\x8e\xb4|IT\xb1Y\x8awf\\,\x80\xe5\x85\x93\xb1{\xc8\xf6n\xf2z\x9f\xa9\x1dL\xfatH\x01\xc7{K\x10\x84\x1d\x81\xcd\xc0	Uw\xce:\xa8\xaaC\xa6U\xbd\xde\xa7\xe8\xd8\x9d\xd3\x9a\"\xe7\x93\xfa\x99e\xc1\x1f:\x0f\x05\xc2z$\x84\xf7\xb9\x90\xc8\x82>\xb4\xe1Q\ni\x82\x97,S\x1c\x81\xe8\xb6@\x01\x1f\x81\x88\xdf~\xf9r\xf96aY\x81<\x08\xc3I!=\x12\x0dk\x86F\xe0\xa6\xdeRH\xa8\x95\x8f\xf8C\xf1}\xa8\xb7\xc6\xe4\"\xe6\xb8\"5\x0eeg\xb0\xa9\xb7\xa4\x8eM\xe4\xd0\xdd\xc9\xa6\xdezm\xff\xf0\xb1\x90oj\x96\xfe\xb2\xc3G\xad\x8e\xb6\xdf\xd3~\xe2X\xe9\xfcFf\xd0a`-\x10uk\xd5d\xa5\x1a\xa3i\x8ep\x80\xe3\xc4\xcc\xdaX~\xbe\xe80\xe3c\xe7\xde\xe8\xac\xe1U\xda\x9d4D%\xaa$\xc5\xf8\xea\xf2B\xf4\xce\x9bM\xbd5\x9e&3\x07\xfe\xcc\n\xb8\xba\xbc\xb0\xdd\x88ri\x95\xc6F\x98\x1f\xd9\xdc\x15\x15\x98\x94\x10U\xc9\x04~\xe5\xb9D\x1e\xc1(\xab\xc3AF<$\x1c\x82\xde\x0c\xe8#\xc6)\x87\xee\x97\xca\xedd\xe1\xb5\x9f\xd9\xbeK\xbe\x001.;To\"\xf0\x9f\xb0\xc7p\x90\xc1\xf7\xb0P\xa5\xe6k.\xd7\xb6\xdc\xa4r;\x10\xec\xd8?g\x19n#x\xa1\xce#r\x04\xa1\xbfcU-\xe9\xd8\x15\x00\x8e\x13\xecGfI\xf8\x8atQ\xec\xd4\x045\x0d$\xe2\n\x97\xc8\x91\xa5\xe8\x86>9\xa0,\x1eP\x07\xba\x12\x14\x0eQ\xdd&`8\x9f/!(\x90\x0d5\x0b'UK\xf8J\xd06\xbe5\x0dL0A\xdb\xba\xc7~3\xe2'\x81\x03\xcb\\?\xd5,\xf4\x9d\x0c\xec\x12MJ\xa0\xadN!\xb4\xdeh*_*\x7f\xfeQf\x8f\xf0\xaf\xe1A0 \xa4\xc0\x9c/\x80Z\x19*~\xaf0-3\x1dL\x8a?\x8c\xf5L@J\x8a\xf0\x7f\x8a\xfe &\xa8\x98b\x19r\xddr\xed\x8a6%\x8f\xa8\"\xf8\xcf\xf9y\x04'zu?\x06\xd8\x06mN2\xc76q?\xd3\x9f\xcdI\xad\xfd\x94mx@a\x02\x98\\\x9e\xb2\xb8;r}s\xa4\x10\x1cK\xa1O\xb5<\x18,e-\xff\x81\xfc\x19\xce\x1f\xcf\xfb\xebI\x8d\x87y\x08c\x8d\xa3'%0\x85\xd8\xc2\x9e\x14\xf1\xa0z\x8dKk*\xb7c\xdc\xa7\xe4\xe3\x94\xc2\x07Sq\x9f\xc2\x03\x8bq}\xd5\xa0.\xd3\x04y\x9f\xdcd\xdd\xde,\xd2\xfb\x17\xe3v\xed\xe9%^\xf5m\xe3p\xa7v\xd1\x85\xa5\x9e\xfc`2\x0f\xa9\x7fZ\x83\xb1\xc5\xb5\xb5b}\x17[\xf4:I\xc5\x00X\x08\x9cP\xcf\xf0\xd9\xab\xd9\xa2\x7f9{f\x95>\x96yp,\x96\xe1h\x95\xee\x8f\x06\x01s\xa8\\\xfe\x9b\xca\xa5\xde\xads	p\xba\xa2\xee&\x0c\xcf\xbe\x92\xf7\x9b\xdb'5\xb5#\x89\xd0\xeft\xe1\x97<\x0f\xf4\x1b\xe4\x9f|\x1e\xd8\x8b\x1b\xeb\xaa\xadz\xe6\x9e\x13\x06\xb7u\xed\x8a\xe9\x86P\xc8D\xd6\x02r&\xad\x97\xe0T\xa3\x84\xdd\x85FT\xf1[L\xe8\\\x0d\xe3\xcf(\x03_\xb5YL\x9e\xa9g\x8a\x08\xfc\xa4\xaa\x8a<MH\x98~\xe42\xee\x15\xeb|CV\xd3\x97\xfd]P\xdb\x97\x8bS}M3\xb3\x13/\x17\xf0\xf4\xd7\x0b\xe5\xb8\xc6\xb8N\xb5\x10Nb\x8e\xaa\x04\x9dn\xc8\xb9\x90\xdc}r0\xac\xb6h\xec\xae\xed\x93U\xc6\xf0/\xc6\xf4\x81+\xdc\x89\xfa\xa9b\xd0\xa1\xb8<\xfao\xef\xd6\xbc\x0b\xfau\xbe\xb1u#\xd2#[}\xa8\x7f\x80\x13\x8d\xa8\x19F\"\x0fp\xf7KRDhn:\xdc\xd6\xcb\xa8\xd7o}H\xb8X'E@ \xa15\xfb\xe4\xd1\xa0BHE\x9c\x89\xa3\xff\x9e\x9f\xef\xf6vM\x17\xf6\x85C\x14|\xfb~\xfb(1\xb8iL0\xcc}\x12HW\xae\x14\x85\xa0\x88\xd1\xf3\x91\xafu\xf6\xe7\xac.\x8a\xf6&\x0c\xa77=\x92\xaf\xa3\xfe\x90\n\xb7\xf5\xd2\x80y\xad\xf7W\x00\x00\x00\xff\xffPK\x07\x08\x0e\x04\xaf\xce\xfb\x05\x00\x00\xa3\x16\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xf4y\xc3N\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00golang/shared.go.gotmplUT\x05\x00\x01\x9d9\xf5\\L\x8f\xb1\x0e\x02!\x10D{\xbfbBl\x8f\x0f0\xb1\xb2\xb2\xd0\xf8\x0b\x1b\xd9\xbb#\x81\xf5\x82td\xff\xdd\xe0q\x84n`v\xde\xec\x96\x028\x9e\xbd0\x8c\x8f\xdb'\xe5\xaf\xc1\xa4z\x02\x80R&\xf8\x19\xf6\xfe7^\x94W\xa0Y\xbb\x0d\xfb Y\x02\xbb'E\x86*L\xfd\x1b\xc6UM'\xb1\xb8\x1e\xaf\xefD\xb20\xce\xef\xd5\x07\x87\xcb\x15\xf6VUb\xe9\xfd\xad$s\xdc\x02\xe5q\xc3\x96\x1ah\x07\xbd\x1et\xe8_\x00\x00\x00\xff\xffPK\x07\x08\xcdsP\xaa\x83\x00\x00\x00\xdd\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00'Q9O\xd7V\xda_\xdc\x05\x00\x00m\x1c\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00elm/Rpc.elm.gotmplUT\x05\x00\x01\xcb<\x8b]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x12H8O\x84\x1a\xbb2|\x04\x00\x00\xbc\x10\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81%\x06\x00\x00elm/RpcUtil.elm.gotmplUT\x05\x00\x014\xdb\x89]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x12H8Oi\x93QmF\x04\x00\x00/\x0e\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xee\n\x00\x00golang/client.go.gotmplUT\x05\x00\x014\xdb\x89]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x12H8O\x9b\xd1\xf2Y\xa9\x02\x00\x00\xec\x08\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x82\x0f\x00\x00golang/pkg.go.gotmplUT\x05\x00\x014\xdb\x89]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x12H8O\x0e\x04\xaf\xce\xfb\x05\x00\x00\xa3\x16\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81v\x12\x00\x00golang/server.go.gotmplUT\x05\x00\x014\xdb\x89]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xf4y\xc3N\xcdsP\xaa\x83\x00\x00\x00\xdd\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xbf\x18\x00\x00golang/shared.go.gotmplUT\x05\x00\x01\x9d9\xf5\\PK\x05\x06\x00\x00\x00\x00\x06\x00\x06\x00\xcb\x01\x00\x00\x90\x19\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xcfQR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00elm/Rpc.elm.gotmplUT\x05\x00\x01\x07\x9c\xd4j\xcc\x1a]o\xdb8\xf2]\xbfb`\xec\x83\x84\x8d\xd5\xec\xdb!\xb8\x18\xd7\x8d\x13\\\x8ak\x1b\xb4\xd9{\xd9.\xb6\x8cD;l\xf4\xb5\x14\xddM\xe0\xf8\xbf\x1f\x86_\xa2D\xd2qr\xed\xde\xb1@#rf\xc8\xf9\xe2p8t\xdd\x96\x9b\x8a\xc2v\x0b\xf9;RS\xd8\xed\x80\xdewm\xcf\x9a5\xa4y\x9e%\xdb-\xfc\xc9\xc4\xad\x02\xf7\x1d)h\xbel\x0b\xd8\xed\x10R\xb6\xc5\xcfU[\xdcA\x0es5B\x9b\x12a\xf39\xfc\x9dlD;_\xd3\x86r\"h	\xaf\x168\xfa\x8fa\xe0\xe6\x01\xd6L\xdcnn\xf2\xa2\xad_\x15\xb7\xe4\x8e3\xf1\x8awE\x92\xb0\xbak\xb9\x80\x7f\n\xd1\x99\xef7}\xdb\xe4KZ\xb4%\x05\xd2\xc3r4~\xde\x98\xf1s3\xbed\x85pD\xc1nf`\xd7\xa4\xbfs`\xd8\x1d`\xac\xa6\x0e\xec\xaa\xed\xd9\xbd\x05\xfe\xfc h\xef@e\x7f\x0c\xd5\xbc\x98\xb1\x0f]\xf1\x8b`\x95Cs\xd66+\xb6>B\xc89\xe7-\x97_\x1fh\xbf\xa9\xc4\x11\x94R\xc0\xd7]W=\xa0\xea\xe7\xc0I\xb3\xa6\xf0\x83\x9e\xed\xe4\x14\xf2K\xf9\xd9\x03\xaaY\x0fo\xb7\x06\xc3\x18Q\xd2\xa2-\x10+AS\x99\x99\xc4CG\xe5<\xd7\x0f\x1d\xedajH	\x97\x16V_\xaf\x9b\xa6\x15D\xb0\xb6\xe9a\xb7\xc3! \x15#\xbd\\\x13\xbb\xd6mN\x13\x00\x00\x97\xe7\xf2\xfe\x08~X1Z\x95\xb8\xa0\xc2\xbe\xc0\xaeZV\xa1C\xd1\xd65m\x04\xcc\xb0?\xd3\x04\x8a\x03I;aa\xbb\x05\xb6\xba`\xbc\x17r\x05\x98mg0;\x9a\xa1\xe3\"K\x8a\xc4\xf0t\x82c)\xa7}[}\xa5\x06\x88\x82g\x06\xc52m\x94\x85\xfd]\x92\x94tE6\x95\xf0\xa4<\xf1\x04\x8f\xa2\xbeT!\x87\x8bw\x1a\x17o\xa9\xf8\x9fJ\xa8\xbb\xbb$\xa1r\xcb\x1c \x1f\xcc\x17p\x9e\xff\x9bT\x1b\x1a#jo\xbehi\xcf\xf3\xf6\xe6\x0b-\x84\\\xf4\x05\xe2\x07U\xf0\xab\xb5p\n\xb3\xc1\xc8o>\xbe\x7f\xa7u=;\x8akB\xc7\x06\xc5f\xee\xfbH6\xe2U\xc7/3\xf4\x1bzBDQK\x1d\x8ex\xc8%\x824\xc6#\x00\xd8\nR\xfa\x07\xa4\x15mF\x8a\xc8\xe08\x93\xa1T\xaf\x0f\xcb\xbc\xdf\x14\x05\xa5%l\xad\x83\x00\xadz\xbag\x8a\x9f\xc6S\xb8\x9aIYS\xd2\xfb\xb1\xee\x8f3\xbd%ttuV\xc7\xf6\xb8\x80e\xae\xac\x86\xca\x8f\xcc\xe0\x1a\xc3'\xaf\xc9\xc3\x0d\x0d\x0dw\x90\xbeEX\x8eg\x8c\xf1\xd9\xf4\x19\x1c\x1b/\xcf2\x9fi\x9c\xde3\x8d\xa7\xc3\x8a\x86\xcc\xf0\xb7\xa9\x19j\xd2m\xb70E4\xfbrd}\x87\x8f\xe7n\x7f\xb7\xa5\xd1\xd8\x156T\xc4`:\xeaE\x0ddZ\xc4P\x13\xf0\xd3\x06\x1bE\xd9\xa8\x81\xb0\x8dG\xa6A\xd8:zd;|\x07\xad?.^\xa4\xf4\x17(|\x8f\xb2\xbf\x83\xa2\x1f\x17\xe3\xc4\xe2I\xb5\xeb\xbe\xfb\xed\xe6\x10\xb4\xd9\xd4x\xa4\xe7\xe7\xcd\xa6\x0e\xe4\x10\x08W'\xb8\xfc\x1a\x1f\xe0h4\\E\xc1\\\xeby{\xa5\xa6\xf5\x0d\xe5\xb8\x92B~+\xfb\x83\xdd\x02\xd9\x83\"\xd1)\xaaw\x98\x9e\xce`\xf6hs\x05\x8d;e\xc1H\x9f\x90\xaa\x9a\xf2	'\xf0/\xd6\x0b\x9f\xff\x10\xee\xe9\x8b\xa5\x8a\x9d\x7f\x07p\x8dK\xfe\x96$\x1da\xbc\x7f\xbf\x8a\xf1\x9f\xc2G\xc1Y\xb3>\xf2$\x81,J\xfb\xed\xe5\xd1\xe7\xb96\x84\xcc1\xeci>1\x0fd1Q\x05\x13\x15\xbd:T^%7d\xfb\xc9\xfeBQ]\x0d\\\xa3(\xa8\x81\xb8\xb4\xbd\xe4\xff\xba\xf5\xacsbD\x9b/\xe0-F\x16\xdfI\xa3\xb4\xbd\xe0Z\xe4\x82\xf4Tv\xdb\x95\xe5\xe0Y*\xc0\x164*\xcc\x17\xa3\xc0\xf3f\xa3\xf7\x91F\xd4\xbc$A\xc1\xb1\xfd>\x9d\xe1]+nY\xb36:\xb9\xe0m\xedIv\xe2i\x01\xf5\xa34\xb5\x8f\xee\xab\xab\x8f\xaf\xff\x956\x1ce\x98\xd9'\x82\x04\xf5\xe5\xe9Ay\xec\xc7\x17\xca\xfa\x14\xf1\xffL`\xeb\xf3\xbe\xc0\xb8\xff\xd8J3z\xd9\x08\xba\xa6\\AX#\x82[\xe0\xb2\x11{\xfc?L\xc5\\\xc9\xd9\xb7\x92\xdc\x04\xb3\xef\xe8\xf6\xac\x11\xcf\xf0\x83\xcbFD)\xfeR\xe3\x07\x9c\xdd3\xfd\xc8\x0d\x9c\x0bv0H\xdb;\xc2\x88\xa9\xe3\xe1\x8e\xef\xdc[\x0fP\x94\x7f\xd9\x0d\xaf\xea\xbb\xa6\xc9Tcj^\xe0\xe4\xac\x11z\x86\xf98\xbf\xdd\x13\x8d$\xa1\x82\x0f\xb4\x83z\xccesD3\xbd\xa0\xba@\xe7\x82\x1a<\xf6\xf6\xc8\xb6\xb4\x02\x8c\x92\xd5\xe0\xd6\n\xcb\xb9t%\x19M\x12;\x9f<\x99\x0f\xb9\x94D\xbc&\x8bf\xb5b\xd3U\xba4\x86_\xeaT\x9fV\xbc\x10\xe2e	\xb6\xb8\xa6\xb6	\xe1k\x9cF#\xbf\xe6\xeb\xde\xda\xd8\xcbHS\xcc\xed\x00S\xd2QjO\xf8:P\xa2r\xbd%M\xc7)\x82Y!\x1b\x95vF\xec\x9e\x80?\x16.\xee\xb8(a\x19\x1b\x04\x0eB\xa2F\x9e\x92R\x0b\xa9H\xa3b\x85\x85\xd2\xaa\xc6v\x9eW\x98\x81\xa7\xac\xa4\x8d`\xc2\xbb\xce\x1cn\x0c\xd3\xf6'\xdc\xc3}K\x1ae('!\x99\xaeM8:\x90\x02\xfb\x0b\x8c\x8dg\xda\xaf>\x9acJ\x9d\xdf\x0e{uX'\xb0\xbf\x07\xe0\xa4\x02\xe5\x8e[\x97\x9dO\xeaG\xd6Ue\x05\n\x82\x15(\xd7\xe3\xfc\n\x943\xc3O\x99;\x81\xeb\xd8&R\x0f\xd8p\x9c\xed\xad<)\x82\xe3\xe4\x80\xdb\xf3\xc17\xe7(\x17\x91;\xf40\xf1'\x82;&%Y6\xde\x95\x10\xad\x15\x0d\x82b\xfd\xd2\"y\xe9\xc5\xef\xb1\x90\x11\xf5\xd2O\x9ff\x80\xffv;\xc2\xd7\xdb\xad\xe7yN\xc0t\x93\x90\xe7\xaf\x1c\\\x1d\x86\xc8\xb5\x7fy\x13\x14\xb0\xbc\x9feq6\x02Q\xd3\x95\xc6\xaf\x88\xc9\xed\x18/\x85I\xa3)C\x07\x9431\xed\xb4<\xf9\xbc\x8a\x97\xe6$\xe2=c\x99\xcd\xc9\xa3\x95j\xfb\xb1S\x89w\x05\xaa%\xffpuv\xb1i\x8a@\xb5\x85w\x85\xacw\xc8\x8fq\xad\xa5\xd0e	\x84\xe8\x08\x80\x8f]p\x02\xea\x15\n\x9d\xf9\xb2\xe96\xe2\xa2\xe5\x13<\x04I\\\xf3N\x05\xef7\"\x88\x99\xc4V)\xd4\x1a\xdb-\x08Zw\x15\x11\x14f\x0cW\xbb\"BP\xde\xcc$\xc9p\x90Vtx7\xb8i\xcb\x07'\xe8c\xc3\xd7\xc0\xfcK\xdf6?#,U9]\x8c\xfb\xa7\x17\xcd\x12;\xbb\x8e\xf0\xe6\x0el\x9a~\xbb\xcb-XE\xe2\xa8\x1e\x90\x885\x89\xe5U\x90\xfe\xce\xae\xb1\x85\x9a\x8a\xdb\xb6\x84S\x98]\xbd\xffx=\x14a\x8f\xe0\x96\x92\x12S\xd6S\xad\xb2\\\x0f8(\x1b^\x0d\xe0\x1b\xd2\xd3_x\x05?\xfe\x08\xb3WF\xf2\x0fWgWD\xdc\xda\x9b\x14\xb6#\xadG\xf9\xc7\x99m\x10\xd8~:P\xc1j\xdan\x04\x9c\xda\x1b\xb6\x81\xe9\xb7\xc4\x97\xfa\xde\xa1~\x97\xda\x17\xd1\xa8\xd3!\x1a\xc9\xf0\xff\xb3\xba\x04\x12\xf2A\xad\xadC\xfc\xaf&\x9d^/\xe6\x89\xdf\xde\xfb\xf4\xf4\xf82L\x0bT\xb6q7=0\xf0\xf4L\xb7\xe3\xf4\x8f\x0d\xed\xc5\xff\xa7\xe7Yi\xd5\xc7A^w\x04\x82\x93\xe2\x8e\xf2\xa0G\x9a\"\x01r\"\x03U?<z[GM;\xce\xf0\x8dY#\x94\xb4\xa8\x08W\xbfA\xf8<s\xd49\xfb\xac~\xed\xf0Y\xdc\xf2\xf6\xcf\xfes>\xcbL\xc9Z\x92\xfa\x91\xc6\xa6D\xa3\xacS\x927\x18\xb8\xa7|\x05\x0f\xd3q]Z\x11\xe7gm\xd3\x0b\xbe)D\xcb\xbdTT\xa3\xec\x7f\xca\x0e\xab\x80j9Fb\x03\xbdG\x1d\x8b\x1e\xc8\xa0\x1c\x89	+\xde\xd6@`EXEKO[\xb8\xef\x94\x92\xcc\xbc\xd3]x2\x9c \xb62\x13\xd3el\x0e\xcaG\xf5I\xec\xea2\x8d\x1b\xa7_wLN\x0c\xacY\xb5\xd3\xba\x8b,l\" \xc7\x8d\xeb\x92\xbf\xc0\x80\xa6\x19\xdf\xd3\x99\xd7\xf0\xfb\x17\x0d\xb7MV\xf8\xac]\xcb`A\xd4\xb4e\xae6\xbc\xaa \x8dR\x9e\x91\xd9m\xea\xa3\xc4*\xa9 \xac\xea\x93\xc9l\xb6=.@\xc5\x93\\\xb4o\x83	\x8fi\x8f\xdaL\xc3\x03j\xc8!\x13O\x81&\x95\xf1X\xf0JX\xa6\x99\xf3%\x89b\x1a\x0c'\x99\x1d\xa5J\x98	\xd1\x15k\xbc8;\xdcE\xe7\xe1\x9bk~\xd9\x8co\xad\xc1\x9d\x99\x82Ms\x9f\xbc\xbb\xc6\xef\xaf\xdaO\xd2\xb5Po\xcdfmy\xd9R{\xdbE\xcf\xac\xb8\xf3\xdd.\xf9\xcf\x00PK\x07\x08\x9bE[T\x06\x08\x00\x00\xa3%\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xcdQR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00elm/RpcUtil.elm.gotmplUT\x05\x00\x01\x03\x9c\xd4j\xc4\x18Ko\xdb<\xf2\xce_1\xf0\xc9\xc2\x17\xbb\xbb\xdd\x9b\xd1\x06\xdb4\xc1\xb6E\xd3\x00I\xba\xa7\x05\x12\xda\x1a\xdbj$Q \xe9<\xd0\xf4\xbf/f\xf8\x10)\xcbI\x80=,\x0f\x89f83\x9c7\x87nT\xb9\xab\x11.\xbb\xd5O[\xd5\x80\x8f\x9d2U\xbb\x11\x00\x00S\xf8\xac\xdau\xe5\x80#8\xd3Z\xe9\xaf\xedZy\xf8\xb2[1j:\x9f\x17=\xea\x12\xcd\xae\xb6\x1e^1\xff)\xaeT\x89\xda\xe3J\x86>u]\xfd\x94a\xae\xac\x0e\x07\x07\xd4\xbfe\xbd\xc3\x8c(\x08A:\xf8Ze<\xf8\xd8\xe1*\x9c\xbc\xd6\xaa\xf9bm\x97\xa9C\xc8K4\x9djM\x10\xdb\xc8\xce\x7fi4\xaa\xbe\xf7'\x14B\xccf\xf0A\xee\xac\x9am\xb0E--\x96\xf0\xee\x98\xb0\xff\xec\x11\xcb'\xd8Tv\xbb[\xceW\xaay\xb7\xda\xca;]\xd9w\xba[	Q5\x9d\xd2\x16>i-\x9f\xa2[a\xcap\x11vO*\xfbP\x19\x04i\xe8\xd3D\xf4\x93E\x9301\xdc3\x114wNeN\x82Oq\x95\xef\x9f\xb5\xd9\xfeY\x1b\xf7O\xab\x95M\x84\x13\x18e\x93\xcb\x92\xbd\x18\xdf#\xb8\xf4\xee\xe1/\xf6 \xc5=2~3\xaaMt\"0Q\x89\xc0D#\x02I!!\xecS\x87 \xebJ\x1a\x9fk\xf0\x91\xc3\xf1\x1b\x96\xd2\xe0O]\xc3\x02\xb2 oQ\x96\xa8\x0d,\xe0{e\x9c\xbe\xf3/\x8cc\x82?B\x88,\xe9`\x11t\xf1\xda\xe9\x90\xd49\x99;\xb6F\x97?\xb4\x1a\xd99\xc1\xb0\xf0Qt\x8a\xc0\xecx\xef\xd8\x9c^\xea /\xacTnX\xce\x92\x01aX|\xe0|\x83\x16\xfe\xc6\xe2\x9e\x8f\xe1\\>-q\xfeP\xd9\xed)\xae\xe5\xae\xb60\x99\x88=\xe6\x95j-\xb6\xf6U\xb1\x7f\x7fQl\xcaS\xb5\x19\xc8\xa6{\xdd\xfd?\x7f\xa6\xd8w\x1d\x9b\x11\xfd7M\xddX\x90\x1fGc8\"a\xdf\xa3^{\x968od\x97\x84+\xa2\xad\"\xf9\"1\"\xa4B#\xbb\xf7!\x0f\x82\xcci\xd8\\WX\x970\xf1	8\x81\x0f\xcf\x91\xcfp\x06\x14\x07y|vf<\x99r\xacX\xcf&\xc7\xc0\xc1aE!\x84H\x9a\xe6HFK\xf2\xe5\x109e\xec\xb2\x18\xdb[f\x02Y\xf9S\\A'\xb5\xadd\xed\x93'\x08\x94my\xbd\xc5\x16\xa6\xff\xe9RYdW\x17y\x8b\xc0,\x84\xf8={\xee/\x0b\xa8\x0c\xc8\xd65l\xd0H\xed\xc05N\xbbE0\xa8\xefQ\xcf\xe1z\x8b@\xfa@Ubk\xabuE\xed\x8fz\x8f\xa1\xbe_K\x8d\xa5\xa0\xcc\x87[\xc6\xdeB\xd5:\xfe\x0eW \xdb\x12J\xb4\xb2\xaa\x0dlU]\x1a\xa8\xac\x81N\xab\x0e\xb5\xad\xd0\x1c\x81\xd2p\xdb\xee\xea\xfa\x16\xd6J\x83\xb2[\xd4^\xfc\\\xcc\xfe\xa4M\xa8W;\xf4!Vk\xd0\x84\x1a4Fn\xf6\xd0A\x89>@\xfd\x05\xf6'4\xbbpk\xb2\xf8\x8f\\N\x0c\xf3\xd7\x9c?y\xcb%\x10\xc3Q\\\xba\xfb\xa9\xab\x18\xecU\xce\xdbi\xbc\x8aAz[<\x18\x14\x00)\x84\xc8\xafIX\x04\xa2^\x19\x98&\x928\x99\x12x\xc8\xbf\xedE\xb93W\xd2`\x8aU\xebX;g\x9a\x83\x00\xb3\xe3\x88\n\xe8i\xef\x15\xd4\xba\xe8\x1b\xcb\xc5\x1d]\xd3$~\xc05\x9b\xc1\xae}\xd0\xb2\x83\xaamQ{\xaa\x8c\xc4\xa3\x84\xc8f\x07X\xf4\x0e\x99\x1d\x870\xe7$\xa4\xff\x99\xd6C\x93\x08\x95\xd8\xd3\xeb<=\x91%]]\xc6\xeab\xa8\xe8\xe4D\x96\xf0\xf3\xf2\xfb\x02&\xf0\xd7_D\"F$\\W\x0d\xaa\xdd\x9e\x95\x93\x1fh\x1f\x94\xbe\x0b\xfb\x131r\xba\xa7	&E\xe9\x99\x00\xde\x1de'\xe5\xaf\xac\xb4;\xc3\xa9?n\x80'\xf8\xacJ\xf4\x868\xc7\xcd)\x1f\xbe\xb6\x96Y\x0fI?Q\xe5\xd3\xb8o\xcee\xbdV\xba\xc12\xce\x18cn\xea\xebb$}&\xdf\xae.~8\xf3<o(\x9e<\xa6\xa8\x13\xc7\xc7Z\xaa\xa8\xf2\x07\x06\x13n\xee+\xde7\xb7t\x8e\xf4\xa3\xaa\x01I\x19\xc6\x83\x11\xefg\xfd\x8d\xa5\x1b\x90\x9aP\xad\x05\xeee\x12Z\xd5\xce\xde?>\n\xc3\xee<\x02\xa3`)Kp \x89\xd4A|I\x83\x9el{M\x1f\xa8#\xd3\x11K\xf2\xe6V\x1aP-r+\xacqm\x1d\xb5\xe8\xa3\xca=\x8fFMnx\x99\xfe\x07\xae\x93\x10\x01\x1f\xd9\xb1\xc2\x8f$\xce\x05\xfa\x86=\x90V>\xc3I\x8d\xb8\xc2\xb8\x013t\xf2\xa0\xeac\x05\x15I\xf1\xfb\xa4\xbfy\x85\xd7\x93%\x8ciE\xbc\xc6\x9d\xd2&\"bQ\xdc@\x83V\x96\xd2JJ\xca\xa10nw\xc1\x9d\xe9\xb3foR\xe0l\x9cP\x1a\xba\xfb\xc6\xdf\xda\x05KM<\x16\xd6\xc5\xddhr\x86\xc5\xfe\x8b\xc9A\x94\x89\xf2a\x11\xd1\x9e\xfd\x07\xfc0\x8d\x16G\x83\xe7./\xa9\xe8\xd3\xb0\xfcK\xa9\xe8\x9b\x9b1\xa7\xe4~\xf0\xb9\x12s\x86-\x16B\x84\xe7\xd7\x0b\xf9\xe8\xf6\x93\xfb+\xf2\x04a>\xf7(\x19\xfc\xac\x16\x9e-0\x1d)Z}C\xb3\x95{7\xc2\"\xbb\xe9(\xdf\x1b\xb3\x19\x9d\x9ed|\x02\x9c9\xd6\xc6l\x82\x14\xab\xce\xcdfT\x1fGp\x15\xb4r\x95\xe3\xc8\x0f\xebFC\x96S,\xf4\xf3\xa8\xd54\xd3q_u\xe6E\xad\xcfe\x07\xea\x8e\xfe\xfa\xab3\xaf\xcf\xb7\xdd\xca^N\xd65/\xee@-\x7f\x0d\xf3\xc9\x1d\xa5\x96\xbf\xe2\xd4\xea\x1b\xc8~\\S\x7f\x17\xfd\xed;l5Y\xfeD\xcfR\xa2%\x96\x04\xd9\x87\x89\x93\xa2\xba\xb8\x83\xfb\xd7&\x88\xe4\x87\x83\xb0\xee\xc5\x9b\x86\x97\xec~\xea\x87w\x9e\x05\xdf\xe2\x85`\x8ac\x18u\x86\xdb\x8a\xbe\xb8g\xf0\xa07F\xa9\xff\xdf\xee8X\xe8/\xfa\xc7\xdbO\x03\x9e\xdd\xe9\xf6\xc5\xd7\xfb\xb5\xf2l\x0b\xf7vN\x86\xfb\xd91\xc8a\x9a\x8dq\x92M\x1aC\xc9\x84\xc5.\xc6|\xfa\x0b\xeb\xdb\xce\xd8\xff\xbdS\xffPvK	<H\xaf\xb0x\x0c>\xfc\xb0M,\x88\xec\x07\xee\x9f\x88\xa6\xe7\x91\\\xd6\xb8\x7f#\x1d~\xed\xba\x18\x98I\x1e\x8c\"\x8c\xd8\xc9\xb56\x12\xeb\x18\x8c}\xe2\xfc\xf9\xd9\xc8\xee\x1f	\xf5!e\x88u2|;\x1f\xa4\xf6\xf3\xdc\x1b\x18\x1a\xfa}d\x8f\xdf?\xf5z~.\xaa\xfe<Z\xcf\xf9[y\xba\xffK\x8b\xff\x1d\x8c\x9d\xdf\xf3\x16B\xfcw\x00PK\x07\x08\xf3\x95\xa5M\x12\x06\x00\x00\x96\x15\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x1d\\R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00golang/client.go.gotmplUT\x05\x00\x01j\xae\xd4j\xb4:ks\xdb6\xb6\xdf\xf5+\xce\xd5\xb4\xbe\xa2KS\xb9\xbd\x9f\xaaV\x9dM\xect\x9a\xddm\xe2\xb1\x9d\xed\x87L\xc6\x81\xc9#	k\n`\x01\xd0\x8f\xaa\xfa\xef;\x078\xe0K\x92\x9d\xb6[\xce$#\x02\x07\xe7\xfd\x04\xbd\xd9\x9c\xc0\x17y)Q\xb9\xf3\xdb%\xcc\xe6\x90\x9dj\xe5\xf0\xc1\xbf\x9el\xb7#\x0fa\xb4n\xf6\xcf\x84\x13qs:\x85\xefD\xed\xf4\xc9\x12\x15\x1a\xe1\xb0\x80\xe9\xf7\xb4\xfa\xb7v\xe1\xe6\x11\x96\xd2\xad\xea\x9b,\xd7\xebi\xbe\x12\xb7F\xba\xa9\xa9\xf2\xd1tJ\xa0\xf8PaN\x80r]i\xe3f\xb0\xd94\x04\xb37~\xed\\\xb8\x15l\xb7\xd3\xc0\xe8\xa8\x12\xf9\xadX\"\xf0\xebh\x14N\xc2d\x04\x000\xbeyth\xc7\xe1w\x1e\x84\xe17T\xb9.\xa4ZN\xffm\xb5\xe2\xb5\xc5:\xee*t\xd3\x95s\x15\xbfZg\xa4ZFDN\xae1\xfc\xdcl\xc0\xe1\xba*\x85C\x18\x07\xcav\xdcp\x0c\xdb\xed(\x19\x8d\xee\x84av\xae\x81y\x88\x8a\x859(Y\xf2\x1e\xa1\xcd\xae\xe4\x1aa\xde\xfe\xdex\x14\xa4\xf8\x02\x17R!\x8cM\x95_\x1b\xccQ\xde\xa1\x19{\xcd3'\x87m\xd7\x81\xa9\x06\x96\xdbnG\xfe\xfct\x1a\xb7\xfbz\xf6\x9b$\xc1u\xb3\xff\x93P\xcb\x12\x8b\xb7b\x8d\xb0\xddfo\x94C\xb3\x109\xb1}\xea\xadp\xbd\x1fr\xc3\xa4\xdcc\x85OC\x82u\xa6\xce\x1dl<uz\x8e\x03|\x14\x03\x8cPK\x84/\xf2\x95,\x0b\xf2TO\xee\x94\xde\x0c\xaaF)\x0c]	\x9b\x8b\x92\xa1\xb3H\xa3\xc3\x81G3\x90\xab!\x85\xaah\x10\xb2\x04\x8bZ\xe50\xc9\xe1\xf8Iy\x13\x90J:)J\xf9+N\x82m\xe2\x89\xa4#Z\x9e\x05N`\x1e\x9d\xb8e\xfd\xe4\x19A\xa3\x81\xe2\x93g\x07\xc5\x9d?'\xf0\xe6sQe;b%\xcdIrTR\x18\xf4\x15\xd61\x99\xa9\xf2\xc6`\x84\xd0V\"\xc7\xec\xe2\xfc\xd4f\x97\xda8,^=\xd2\xf2\xd0\x86K]\xe8\xdc\x9f\xce\xce\xe2\x8f\x97Ji'\x9c\xd4\xca\xc2v\x1b\x8d\xf2\x8cM\xc87M\x95Ga&\x0d\xeb\xf4\xe4\xeea\x18\xa2iG\xb6F\x08\xa9\n|H\xe1\x0baB4\xbdQU\xed\xae\x1e+\xb4=\xbe\xf9\x940KO\x8d\xe8\xf2Y\xb2\xc8f\x03\xc2^\xe0\x02\x0d\xaa\x1c\xbb\xe1;1huy\x87^\x02O%\x81\xed\xb6\xcfI\xd7-\xe9I8\xc7<\xc3\xe9\xbb\xda\x1ddU\xd7n\xb3\xf9\xcb\x18\xa4\x07\x8d\xa1\x7f\xda\xb4\xa0\xddP\xa0'\xd7\xca:X\xa3[\xe9\x02\xe60\x8e\x19\xe2\xe2\xfc4\xa6\xfe\x81	\xc7\x0d.z*\xf1Xj\xe1c\xe5\xc3G\x19s\xd3f\xdb\xa7\xd2	\xadh\xcb\xeb\xe7,\xf9\xa45[\x81\xbaa0\x94\x7f;\xea\xbd\xde\xd4\x0b\"z\xe4\xabT\xf6\xaa^,\xd0\x0c\xc2P.Ha0\x07*S\xd9[\xbc\x7fMu\x0b\xcd\xe4\xa6^$Yx\x99\xb0\xcc\xc9\xb7\x1e\xf6\x7f|Q\x19\xa8\x95\x1e\x83\xae6\xea)\x86(\xd1\x1b\xfc\x05\x8e\xa9\xf6e\x17\xf8K\x8d\xd6\xf5\x0e\x18\xfc%e\x8e<\xcc[\xbcg\xb0\xc9\xf8\xfc\xdd\xe5\xd58m\x12Zv#,\xbe\xbf\xf8'|\x05\xe3\xe9g\x981%}$\xfb\xa4\xff\x13\x12\x914s\xfa?\xfbY\xba\x15\x97\xddI\xee\x1e\xfa\x84\x08\xe0G\x14\x05\x9a\xec\x12\xddd\xec\x01\x95;\xa1@\x19\xa70\x16UU\xca\xdc\xe7\x9a\xd00$\xfb\x14g\xabFs\xb6\xd2\xcab\x0f\x86\xf6\xa3\xee\x1a\x1d\x15\x9a\x98I\xd9\xe1Sb\xe4\xf7\xa9 \xd8\xe2\xe8\xca\x08e\xa9n\xbf6F\x9b\xcdO>~f\x0d\xda\xd7\xc6\xcc\x08t\xfb\x07\x9c\"\xb8\x8d%O\xfd\xb0\xd9@\x89\xaa\x9fF\xb6\xdb\xfdqv0\xc6\x9e\xcaA\xf4\x1c\x0d\x12Q\xbaS^v\xe3\xaa\xf3B\x8a\xaeKG\xfc\x1e]\xf8\x9f\x83\x98\n\xfb\xd9\x05\xcb5\x8f\x12~\x98}\xdc\xa7\xfa9\x14H1\x17pM\xa2J\x839\x03\xae?\x1fyr\x11\xd9\xf2\x06<loR\x81\\\x84\xc8\xf1\xa06\xd6\xda\xeec\xef\xa5\xcbW=\x94\xd9\xa9.pOV\xe8\x98\xc9\xa0\xcfG\xcf\xe0\xce\x85E\x9f\x97\xad\x12\xb7T\xd5p\xd1\xc4\xf0l\x07:\xf6\x8fne\xf4\xbd\xfa\xfc\xa2gp\x91\xec\xa3\xce\xca\xa2 \xcc\xde\xab\xb50v%\xcaIO\xd03tB\x966\x85\xa3@4\x81yH GG\x91\x8d\x83\xea\x8dO\x0f\xe1k\x1fc\xe1\xe8\xde\x13\xbbl\xc6Fh\x8f\x04\xbf\x0b\x98\xdcj\xde\xe3ft\x18\xd7\xc0\xcdv{\xd8\xd1\xc0\xe0\x9f\xd7?\xb7#N\x7f\xf6\x98p\xab\xd4m]\x02\xca\xc6rQ\x0dqz\xe4\xa2\xb8\xd9L\x8f\xa1\x8b\x0c\x8e\xa7\xc4\xde\x13\xc42B9\xf2\xb3C\x08\xc4\xfe\x90\xe0C\x81\x06\x85\x8b\xf3\xd3\xf8\x1b\xe0\x13\xb9\xc9l\xec[\x8e\xf1'\x0f\x18\xa3\xbe\xd7\x1cD@N\x04\xe3O\xa3\xed\x88\xe6\xd1\x9f\xb1,On\x159\xae\xc7\x01\x94\x07,,\xb4\x81\x85\x90em\xd0\x82[	\x07\xc2 (\xed(S\x94\xc2\xd0\x00\xab\xc0\xad\x10l\x85y6\n=M\xe8\xcf(\n\xdf\xa8;Q\xca\xe2\xa5Y\xd6k\xea\xfc\xa9\xcf\x91a\xedZ\xf0\xe2\xb8\x81~\xafD\xedV\xa8\x9c\xcc\xfdTM\xd0u\x7f\xad\x05>G\xb3\x96\xd6J\xad\xcePI\xf4-T\xd5\xac]\x17~\xb1\x05\x7f\xab\xdd\x0f\xba\xa6\x86\x9d\x9f9\x8c\x95v\xd7\x0bZl\xc1\xfc\x8c\xa7D\x19\xa1\x08L\xf2\xda\x98\x06\xd4\xe9\x14\x1a\xc5K\x0b\"\xea\xcb \xd5\xa40\xf9{}\xa0\xb9C\x93\x01g\x97F]\xa4Q\xda\xa7\x0e\x99t\x192nAx\x17F\xaf\xfd^\x11\xc2\x1a\xa4r\x9a\x10\xa4p\xbf\x92\xf9*P\xb2\xd9K\x0b\x06E\xbe\xf2&1\xba^\xae\xfc\xa9\xc8VFn\xe3\x8b\xb2%\xac\xf7\xd2\xadt\xed@\xd0\xa1B\xdc\x94\xc8\x1c\xb3\xe1nt\xf1\x98\x82\xad\xf3\x15\x08B\xa8-\x06N*\xa3\x1f$\x12\x17\xf4\xae\x1c\xe8\x05\xd1!\x9cA\xb8\xd4\x0b\xd0\x08N\x84@x\xc7\x81\x02\x8d\xbc\xc3\xa2\x15\xe9\xc7\xab\xabs\xb0N\xb8\xdaf\xec\xdb\xccn\xdf\xbbC9\x07Z\x94j\x19\x8d\xd0\xf8\xf7	\xfb\xf6\xa5\xc7\x04\xa4\xa1\x06d\x0f\x18Y\x14\xe0 6b\x95\x11\xfe\x84\xd6\xd2\xed\xca\x01\xc8u\xd8f`N\xbb!5_\x88\xfbx\x98\x99d\xf3\xa5z-)\xc6\xdd#\x1f\xa3\xe4\xca\x99\x8e\xa3\xb6G\x83d\xdb\x8eFa\xba\xc36\xbe\x13:\xa8\xcd$\x89\xcc\x85\xca\x16\"\x180c\x95}\x05\xe3\x19\x8c\xe1+\xbf\xe2\xb9=\x80\xed\xbd\xba7\xa2\x9a$\xec\x06\x03l\xaf\x8d\xe1\x94\xd0\xd4\x9c\xbf_\xbe{\x0b\xa2\xb4\x1aD\x9ec\xe5,;\"X\nia\xa1*\x85T\xcc\x9d\xa5\x00\xd0e\x81\x86\xbd\xc4f\xfbD\xea!\xa7\x16\x1f>|\xa4\xe1\xa0\xcf\x15u\xe9\xaczF?\xe2\xaaH\xd5b\xc6\xb3B\x83\x8b\xf0\xa4p\xc4'\xb8U\xe1\x8a\xd8\xb6\x03\xc7t\x7f\x13\x95\xbb!\x17\x99\xf5B?\x8d\xce0\x8b\xb4\xdb:\xc6J\x8fWY\xdc\x82{\x7f\xb6+\xb9n\xd0vu\xba\x8f\xc7\xc91\x81'\x13L\x12\xd6v\xbf\xad\xa5\xd4\x12hQ`\xad0\x84\xaa	\xf3\x07\xe4\xba.\x0b\x9f\x85o0\x18A\x1bP\x9a\x8a\xa7\x8f{\xb8\x17>\xf4\xb9\xa6\x14\x19\\\xad\x10jU\xa0)\x1f\xc9\xbd9\x01X\xa8m-\xca\xf2\x11\x04\x1c\xd7\xa6$\xe3k\xc3\xf19`h_\x94vL\xc2\xbe\xed\x11\xf7\xfc\xae\x8f\xe6\xf7\xfb\xf2kc2>\xf3$\xde\xcf\xf5\xea3\x9fo\x0f(YP\x1e\xcc\xd1\xdaE]\xb6\xda\xec\xa9\x9b\xf3u\x1aUG8o0\x17\xb5Eo#\xbe\xfe\x12\xaa`\xff\x87{4\x08\xed\xcd\xb0O\x89\x85\xa4	\x98\x00\xa9n\xc6\x94\xd8\xe5\xed\x19}s\x02\x94\xca=\xad\xfe\x0e\xca\xa7u\xbfX\xbb\xec\xb22R\xb9\xc5d\xfc\xa5\x9d\x05A\xc9Y\xbe,\x1aU\xcc\xe0K;N\x1b;\xd1\xaf\xc0\x08\xfdzmLr\x98\xf6g\xd9\xc7\xb3\xbdg\x06aOK\xf7M\x9e)\xf7\x8cp\x1c\x8e\xf43HA\xb7N\x04Qe\xaft\xf1\x98\x9d\x96\xda\xe2\x84g\xdan\x12y\x8b\xf7AWf\xd2@'YX\xe2\xa6;\x89\xc9\xc7\x03\x04\xc1)q\xc0w\xf0\xf5\x8b\x17\xf0\xdbo;\x1b\xdf\xc3\xd7\xdf|\xc3\xb2v\x12\x17\xf7\xe4\xe1@\xd3|\xeff*6i\xda\x1f\x98\xfc\xe1\x14\x8e\x06)\x8ct\xfd\x836\x81\xfad\xc0I\xd2Ij\x9d\xad6\xb1\xf5.1\x0fM\xe2\xec*G\x1d\xa7\xda\x99\xbf\x03\xfd\xd9P\x15\xc3\xc1|\x0bXZ|~\x18\xecJ\xdex]o\x91#a\xdep0\xa0\xcc\x82u\xf8W\xb2l\xfc\xb4\xaf5\xdbD\xd5 Hx\xca\xe4\xfd\xb0\xe6\xa7C\x7f5\x14Xx%\n\xbe \x9a\x0d5\xb6\xa7\x15\xde\x8b\"4\xc0\xda\xc8_\xb1\xd8\x8bd\xd0!\xefE\xf2\x8367\xb2(P\xed\xc50l\x9b\xf7\xa2\x88\xcd\xf2^\x0cq\xd3\xef\x15\xb8\x10uyH\xe2\xd07\xb3\x05\xe2P\xc3\x1f\x01z	\xee]E\x17N\xd6Cv\xae\xb5\xf9\xf3\xd2\xe0j;\x04/u\x94\x8c*$\x84\xce\x87\x93x\x1f\xd7\xb4~\\d\xcf\x02\xb7\xf4\xb9\x89Z\xe2R\xae\xa5\xb3\x90\x8b\xb2\xb4\xb0\x16\x05R\xef\x12\x12\xb8\x85\xdc\xa0\x88m-\x01\x0b\x88\xc7\xb4\xe9\x10\x8f3\xcf\x00\xf5\x1c\xfe\xff\x05\x1c\x87\xaf[\x97\x98kU\xf81\x8b\xe5\xa4\xbb\xdf\x85\\\xf2T\x15\xbf\xe9e\xf0*\xf0\xdd\xb6\xe3\x9fH2;\x9bN\xf1A\xac\xab\x12\xfd\xd7DQ\xc9O)8q\x1b\x1a\xfc\x8a\xca|\xe1/\xae5\x0d\x98/\x8b\xc2\xf0\xc0 \x83pX\x84\x9d\xd0\xa7\x11\xefYW}\xbe\x0f\xc0\x02\x84=\x916\x14B\x8b.%~\xb5[\xa1\xb9\x97\x16A+\x04\xd9j\xc5W\xb1\xa6\xb6\xfbr\xc7\xea\xc9\xe0%(\\\n'\xef\xb0QY!-M\x1e\x16\xa4\xe3Z\x175\xd1s\x03\xcfz|:\xd5\x8e\xf5\xb2\xb3\xde\x11\x02v\xbd\xa0e\x0f x\xf7\x05\x0d{WFV\x15\x86\x16-\xf2\xe7\x1fo\xac\xb3\xda\xf8\xcbO\xbf\xed\xa7Ajyi\x84\xfb\xf0\xb1\xf3\xca\x0e\xf5F\xdd\xe9[\xdf\xea\xaa\xc2\xf6z4\x9a\xf3\x84\nc\x9e*8\x11\xd8\xceh\xf8\xbf\xb6)\xab\xac\x92\x88\x8c\xaa\xe0d\xdf\x07\x1aNq\xac\x81t\xf7\x02;\x81\xc9\xb08\xfar\x9b0\xb3\x0d\xfb@#\x80\x05\xbcC\xf3\xe8]\xa4q\xff\xd6\x1d\xd38!jC\xdd\xfcR\xde\xa1\xa2%\xb6\\\nN\x83(\xfc\xe4\xba\xf2\x17\xca6\x85R/\x97\xd43hC\xbc\x1a\x99\xdb\x0c\xde8X\xd7\xd6\x052\n\x1f\x1c\x1d\xa4\x0b\x15\xa9j\xf4\xe1\xe5\xa9\xd0v\xa3\x88\x96\xd1?\xae\x8c4\x10c\xad>\xa1\x1a\x9f\x95\x82\x08\xff\xc0G\xf6\xc8\x0d\xdf\x8bH\xb7\n\xd7\xe5\x8d	Ed\x85/D\n\xb6|\xc0@\xc2\xb1\x0fpN\xf1\x12J\x97\x82V\xe0t\x05zA*kOX\xd2b\xb88@aJ\x89\x863\x92\xd3\x1d\xf2<E\xb5\x0b\xfb=\xe4\x16\x1fS\xb8\x13e\x1d\x07\xa6d\xa88\xae^\xcc\xed\x8c\xbfn\x04\x19\xf9\xfa\x98\xea\xff\x83\xb4\xce\xebU\xdf\x12P\xee\x1e\xb2\x7f\x11\xdaI\xa3\xa8\xcd6\xc9&\x9d\xc3\xc9\xb7\x04\xdbv\x0bLa\xde\xe0\xa2\xe6KQ\xf3\xd5V\xe4\x00\x93\xbd,\x8aI\xcby\xd2-\xd6\x91{\x12<0\xe0\xbf\"t\xb8\x88/m\xf3\xf9\x16\xef'\x9a\xe6\xd4c\xf6\xd5$~\x8ef\xf6\xb8I\xa7{\xf3P5Z\xae\xf9\xc4\x0c\x8e	C{!\xfflU\x9a=\x0f\xb2\xe9\\\xf0\xb7\xa9k\x06D)k\x17Z \xaec3\xb6\xa5\xcd\xae\x8c\\_\xd6\x8b\x85|\xf0\x02fM\xc1\x18O\xc7I\xdaQ\xac\\\xc4\xa2\x12k\xe1|\x0e\xe3q\xc7<\xc3m\x18\x93-g\xd3)\xdd!x\xe4\x94\x8d\xf7\xa2lY\xdd\xedY\x1dg\xd4\xd9< \xe1\x0c\xdb\x90\x95\x8b\x06d>\x87\x17\x1d\x86\xba\xa7\xe7\x83z\xda\x00\xb5}c\xc4\xf2\xdd\x13H^\xb4\xe7\x9a_{d\x80\xa3N\xe9\xd84e\x83\x0d\xd3\xbc\xa7\xb1\\\xcc\"\xf1\xd85w\xf0>\xeb\x04\x87\xfe\xa8 \xfa\xbb?\xdf\xb8r\xfb\xb7\x17	\x84/h\xff\xc5\xaa\xc0z\x93\x0b\x8e\xa0?\x11\xecT\xee\xda\x08\xf6\x9f\xd0\xc2\x1ftp\x16\xe8\x9b\x99\xa0\xafc\x9ej@\xf9h\x1ft\xf0\xcdr_\xa6\xd8\xb5q\x7f\x9a\xf1\x05\x80\xc8\xf8Z\xb2\xf3\xa7I)\\\xff\x81\x8a\xdaa3Z\xae\x13\x17\xd9\x99\x9e4\x1f8\x037$\xb3,\x1e\x88\x8f\x12\xd5$\xcf:\xe5\xd8&p\x02\xff\xf7\xad\xdf\xff~\x0e/\xfc\xaf\x93\x93\x0e\x0d\xd9\x02s]\xa3\xa4\xdc\xc3\xf1A\x16\x0f\x1f\xa9h\x93\xb8\x9d\x83\xf4\n,\xfc_\xe2?\x03=tX\xdd\xf9\xe2\x1bxOv\x0c\xd5	\x80\xc0\xf0\x9eo\xc5\xdb\xd1\x7f\x06\x00PK\x07\x08\x88V\xc6\x1eJ\x0c\x00\x00\xb8'\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xecYR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00golang/pkg.go.gotmplUT\x05\x00\x01M\xaa\xd4j\xdcX_o\xdc\xb8\x11\x7f\xd7\xa7\x98\nN!\x1dv\xb5\xefn]\xb4\xc8\xb5\xa8\x0b\xe4b\x9c\xdd{9\x04\x0dW\x1a\xed2\x96H\x95\xa2\xd66\x04}\xf7b\x86\x94D\xed\x9f\xc4N\xde\xea\x97h\x87\xc3\x99\xdf\xfc\x1ff\xb3\x81?\x8b\xce\xea\xf5\x0e\x15\x1aa\xb1\x80\xcd_\xa2\xcd\x06\xfe:\x13\xb6/\xb0\x93v\xdfm\xb3\\\xd7\x9b|/\x1e\x8d\xb4\x1b\xd3\xe4\xd1fC\xac\xf8\xdc`N\x8c\xb2n\xb4\xb1\xd7\xd0\xf7\x90\xdd\xf2\xf7\x9d\xb0{\x18\x86\xa8\xef\xe1I\xda=d\xbf\x88\x1a\xdbF\xe4\x98\xfd\xacs\x18\x86\xcd\x86\xcev\xba\xd09d0\x0c}\x0f\xa8\nX\x0fC\xd4\x88\xfcQ\xec\x90\xa5\xd15\x92C\xccW\xcd\xe3\x0e\xaeo\x98=\x8a\x9cNH\"\x00\x808\xd7\xca\xe2\xb3\x8d\xdd/T\xb9.\xa4\xdam\xbe\xb4ZyZY\x8f\xa7\xb5\xb0\xfb8bj\xdf\x03\x18\xa1v\x08W\x056,\xdc\xe1o\x19\x89cY\x83,!\x0b\x08\x90}\x10jWa\xe1\xd1A|bx\xec\xef\xc2d\xd5(\x8b~\x93Ei\x14\x1d\x84\xf1\xf8\xff\x03\xde\x80\xec\xbd\xfb\x17n@\xc9\xca\x9f\xdd\x00\xd9\x91}\x10\xa6\xdd\x8b\x99X\xd66\xfb\xbb1\xda\x94\x13\x89L\xcb\xee$	\xef\xfb\xd12%j\\\xc1\x95}i\x90-\x9cC\xf1\xf0\xd2`\xeb\xc3d\xb1n*a\x11\xe2\xd6\x9a.\xb71$\xee\xe3c\xe9<\xcf\x02R\xcfMV\x0c\xc3\x19-h\xcc\x91\x12\x86\xf8z-h\x0c+\x89\xcaN\xe5\x90\xe8\xed\x17\xf8\xa9\xef\x9d\x150\x0c)\xb0\xbc$\x85\xd6\x1a\xa9v\xd0\xb3\xf1\x06mg\x14\x07\xa2\xd2Oh \xb1\xd2V\xdex\x92\x17GCD9\xcb\xb7\xdf\xeb\x02\xfd\x95\x16\xec\x1e!'\x82\xdd\x0b\x0b\xb2@ee)\xd1\x1d \xb1\x83V\xfc\xe3I\x1a\xcc\xbe\x8e\x8b$\x7f\x05\x1bY\x97\x11\x8fG\xd4\xf7kW\x1f|po\x85\xed\xd8S\x84\xf4\x9f\x0f\x0fw\x9e\x12B%2\xb4\x8eN\xa0Z4\x074`\xb0m\xb4*Z'\xae\xd4f\x86\x7f\x19\xf2\xac\"IA*\xbbt&%5\x81\xa1\xc0\xad\xc7\x88\x7f5\xf6\xaa\xab\x8f\x83\xaf\xbaz\x8c\xbd/v\xaa\xff\xecoJi+\xac\xd4\x8aN)\xb3 \x082}\xcb\xd2	\xccn\x95\xc5\x1d\x1a\x18\x06\xa9,\xa9\xafZr\x9fsq\x00'\xd7\xaa\x1d\xfbAP\xd75\xd6[\xe4\x9ct\xe2>\xf0\xef\xb0\xbe=\xb0D\xaa\x02\x9f\x17\\?\xeb\xbc\x1d%P\x12\x05\x10\xfb~\x12=\x0cp\x13\xa2w%\x1d\xf4\x8ec;\x88\x97I\xbf\x89\xaa\x9b!\x0e\xc3l]\xdfCc\xa4\xb2%\xc4\xef\xfe\x1bCr\x86\xdf\x03\n\xfbK:Z4QSN%\xbe7\xa7\x91\xa8*\xa8\xbd\x1bt\x19B_\x81t\xa9\xaeM\x81\x9cB/ \x0cB\x81y%\x0c\x16c*\x05WR/=I\xe1\xf7O\x01}\x99K\x8b\xa3>p\xd0\xab\xe3\xe4\xd9\xcf\xfa\x7f\xb5\x108\xdaN\x94\xc1\x97\xfdm\xfb\x9b\xa8d\x01\x06\xa9I\xb7\xf0\xb4G\xbbG\x03\x07\x90-h\x85\xe4\x07\xbb\x9f\x0d\xbd\xe0\x9f\xd1\xfeCHLG\xe9I\n[\xad+o9A\x91\xe5\x85\xack\x9f\xa4\xcd\xf7p\xf0\xbc\xb9h\xb9\x00\xbc/d\xf1\xbc\xba\x9c\xb9l<I\x96\xc53\x19\x0fS\x11\\\xf2\xcf\xc4p=9\xca7Lk:dZ\x81\xa5\xe8*{\xc2P\x8a\xaaE\xef\xcai\x84Q\x05\x8e\xf5s\xc2\x174\x0b7 NK\x99\xeen6p\xcf%\xec%\xb8\xee\xc6\xd1\xf5\xb1p\xf0W\xe0{\x99\xea\xc8\x85\xdc\xda\x0e.\x9f\xb9aSz*m\xc7\x80]\x88\x90Su\xdc\x99\x8f\xa2\xf0\x86\xae1\x06\xecl:\x9e81^\x1c\x9f\xdf\x0d.\x06\xa0\xb6\xd9\xbd\xeb\x05I\x1chL\xde\x15iL\xf5j\x93C\x9a\xfa\x08\x0d\xd1\">op\x85\xd7\xe6h\xc9!]v\xfdI\xd2r\xe4\xfd[\xd5n'\xf9\xd7\xfd\xc7_\x92mW\xc2\xef\x9f\xb6/\x16S?7\x9d[\x0f\xc2\x80\x11O\xdf\xd3\xd4	\x99,\xc1/\x15\xbc\x05M:I\xdf\n\xfeh\xc4S\xfa'\xe6\xf8\x03\xefL\xd0\x1f\xe78\x1a\xe3\xdd3\xca\xe3\xfc!\x89\x815\x89\x13\xc4G\xd9\\\xd0\xb3\xb4\x9f\x0ep\xe3\x8e\x99487\xcf\xe7\x14P\x1e\xbe\xb4\xc7\xf0\xe0\xfb\x87\xa8\xaa\xad\xc8\x1f\xfdd\x1c#M\x7f,,\xd0>\xce\xdaYX\x10\xc6#{\xe6\xbd/\x89\xa5:\x10\xd0P\x94\xb7\xee]\x1b\xaf`\xdb\x95\xe9t=(\xcc\xb9\x9e\xbdLZ6\x17\x8b\x1d\x8fd\x1aY\xa6\x149\x82\x9c\xbe\xe6\xe6\xb6\\/M\x93\x1f\xcd\xfe_\xef\xde\x9f\x19\xb3\xc4\xc8;\x00\x7f,\xf7\x80\xc0\x8a$\xb7\xcf\xc7Kq\xd8\xe6\xd7S\xaf\xa4\xa9\xbd\x82+a\xdc\xe3\xe0V5\x9du\x8bm\xe8\xf0\xbe\x07av\xb4\xfa\xb2b\x7f\xcf\xef\x1a\xa2\xfd\x15K4\xa8r\xe4\xe8Ab\xb0\xd5\xd5\xc1\xff\"\xd94m\x97\xfa\xc31\x93B\xb2p\xf3r\xf3\x1e\xa1}\xec\xecEl?\x8a\x80\xfe\xb8\xe8V\xcbM\xc0\xc7\xd3u\xe2\x02K\xa9\x82\x0d\x9f@\x84\x0f\xab\xbb\xc7\xddD\xe3~\xc1\x01\xd5\x05\xf2\x9b\x01\xd6\x8b=\x8e\xe9\x14H\xf7\xf5\x8d\x8d\xce\xa9\xbc\x98=\x8d\xd1\xcd\xac\xed\xce\xe8\x06\x8d\xa5%|\xb4o\xda\x1f\x99\x95\xf5\xba\xaf\x93\x0cjD\x9b\x8bjJ\xa4\xd7x\x97\x05=\xf8\xe7\x0d|\xa6>sM\xbd\x96>\xd8r\xe6\xa0\x9d\x1d^D]]\x87}8\x86b\xcb\x84V\x89G\xff\xe0\x18\x86\xf8\xf3d\xe8\x98'\xc7O\x1a\xcf\x99\x82\x7f\xd7q\x0fM!q-t\xe5\xa296 \xddY\xbav}\xe3\x1d\xb9l;o\xf4\xa5Gv\xd6Q\x1e\xcc\x830;\xb4?\xe8\xac\xcf\x0b\x90a\xbd\x9c_\x01\x7f\x0c?\xff\xf7\xc3d\x00\x9ao\x83\xa7G\\v*(]]\xc6\x1d\xf6\xcc\xf0Q\x9e\xb8\x00\xa5\x97\xa3\xfc\xbaY)\xd5\xffW\x98\x87\xe853\x9c\xad~\xdb\x14\xff\x0e\x7f\x9c\x8f\xb6{\xbb\x89v\xc2\xf4\xaa\xcc\x91\xeaB\xee\x9c\x94\xfd\x99!\xbb\x06T\x05\xac\x87!\xfa\xdf\x00PK\x07\x08\xb9\x989\x0c\xce\x05\x00\x00\x86\x13\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x05\\R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00golang/server.go.gotmplUT\x05\x00\x01;\xae\xd4j\xb4;]s\xdb\xb6\x96\xef\xfa\x15\xe7j\x1a/\x992T\xee\xcc\xbd\xfb\xa0\xae;M\xf3\xb1\xcd\xac\x9dx\xe2t;\xb3\x99\x8e\x0d\x93\xa0\x845\x050\x00h\xcbW\xa3\xff\xbes\x80\x03\x12\x94(\xc5n\xb7~HD\xe2\xe0\xe0|\xe1|\x01\xdcl^\xc0w\x86\xeb;\xae/n\x170?\x85\xfc\xb5\x92\x96\xaf->\xbe\xd8n'\x0eB+e\xc3\xf8\x1bfY\x18\x9c\xcd\xe0?Xk\xd5\x8b\x05\x97\\3\xcbK\x98\xfd\x88o\x7f\xea_\xdc<\xc0B\xd8e{\x93\x17j5+\x96\xecV\x0b;\xd3M1\x99\xcd\x10\x94\xaf\x1b^ \xa0X5J\xdb9l6\xdd\x82\xf9{\xf7\xee\x82\xd9%l\xb73O\xe8\xa4a\xc5-[p\xa0\xc7\x89\x9f\x08\xc9\x04\x00`z\xf3`\xb9\x99\xfa\xdf\x85\xe7\x85\x9e\xb8,T)\xe4b\xf6\xbfF\xc9\xf0Nk\xa5\x03x\xb5\n\xa0Bu?fB\xb5V\xd4\xf4\xbc\x12+N?%\x0f\xd0\x92\xdb\xd9\xd2\xda\x86\x1eu+\xadX\xf1Y\xc9o\xda\x05\xbd3V\x0b\xb9\x08\x0b\x99\x07Y\xd0O\x84\x9cN\xdc\xef\xcd\x06,_55\xb3\x1c\xa6\x9e)3\xedd\x01\xdb\xed$\x9dL\xee\x98&N\xaf\x80\xd8\x0b*\x83S\x90\xa2\xa61\xc4\x9b\x7f\x16+\x0e\xa7\xfd\xef\x8dC1\xd9l\x00J^	\xc9a\xdahu'J\xae??4|\xea\x94J\xa4|\xc3.:\xa8f\xc4.p\xd0>4\x1c.\x08\xfb\x15*\xb5\xb9]\xe4\xe7L.j^~`+\x0e\xdb-\x08i\xb9\xaeX\xc1a\xe3&\xe1\x1f\xcd90%Ia| \x7f\x1fpu\xd2\x04\xcd\xe4\x82\xc3w\xc5R\xd4%\x1a\xaf\x9b\xf6\x1a\x9f4\x97\x1d\xa5\xd1\xa2\x9eP\x07\xbf\xb3n\x87\x93\xcb\xb2\x9b\xb9\xfd#K\x0d\xd4<\x14\x7fB\x1a\x8de\xef\xa9I\xc7H\xc0\xbd\x19\xc8\x99\x1cA\x9b\xe3\xe4\x89S\xc8'n\xda\xda\x82\xb1\xba-,	\xfd-\xee\x01\x00\xe0\xf4\xbf\xff\xbb\xc6]2\xf7\x1bdz\xed\xd6\xfe\xc4m\xab\xa5\x81/\xbfwz\xdbl\x03\xa0\xf6\x83\xd3\xeb\xc9v\x82\xfb\xfa7^\xd7/n\xa5\xba\x97\x84\xb8P%7P)\x0d\x15\x13u\xab\xb9\x01\xbbd\x16\x98\xe6 \x95\x85\x92\x175\xd3\xe8\x08$\xd8%\x07\xd3\xf0\"\x87_\x98,k\xae\x0dx\xfc\x88\xd9.\xf9\n\xee\x85]z\xca\xab|R(i\x82\x03x\xadJ\xfe^\xde\xb1Z\x94\xaf\xf4\xa2]qi\x01Na*\xfc\xbb+F/\xfd\xfeC\xe8_%k\xed\x92K+\n\xe7\xc2\x10\xba\x1d\xbe\xeb\x81/\xb8^	c\x84\x92o\xb8\x14\xbcD\xe0\xa6{wU\xba\x97=\xf8\x07e\xdf\xa9V\x96A\xaa\x08.\x95\xbd\xaa\xf0e\x0f\xe6lW\xb2:@!\x98\xa0wS\xdc\xb3\xb3\x19\xa9I\xa0\xd48\xdc\x0b\xcdA\xf3Fs\xc3\xa5eV(	\xaa\xf2\x92\x0e\xb2\xe2%TZ\xad`I\"\xcc=\n\x13K\x9a\xe4\xe9\x84\x0d\x05\xd3\xfa\x01\x1f\x85\x06\xd4\x1bj\x0c\x98,\x9d\x8ap\x1d`\xb5\x92\x0b`\x88\xc22Q\x9b\xdc[\x95\xc3;4*\xe4\nY\xf1\x8eo`R\x88\x96,\xea\x9c\x1b\xe3\\\xf9>\xd4\xca\x0f\x11\xe0\x1b\xbf\x1e\x8c\x18\x1e\x91\x92\xa9\x95\xc0\x0d`\x1f:\x13tdU$\x0d\x03,X\xa2\xb3\x1d4\xb1\x85\xb8\xe31\x9bh\x9d+f\xd1\x08h\xf9|R\xb5\xb2 L	RN\x1ce\x04\xdb=2\xbd0\x90\xe7yDaJ\xeb\xf9m\xe6\xc9\x80\x13\x87k\x83\xf2\x99\xbb\xa5\xb3 \x859T+\x9b_6ZH[%\x1e\xbd\xc7\x9b\xe7y\xba\xc5}\xe5\x88I8<wHROW\x92\x12\x11\xc3\x85xNx\x0fND\x1a\x0eN\xc6A\x92\xe3\x1b^\xb1\xb6\xb6\x97\x96\xd9\xd6\xbcS\x1aV\xac1\xc1\xd6\xac\x82_>\x7f\xbe\x00\xe3F\xf9\x88\x959q_\xff\xe4\x01\xaei{#^gt\xad\xe1\xde\x0f\xf8\xf1\x0c\xee{\xc7\x81\xe21\xb8\x1a\xae\xe2\xed\xb25-\xab\xbb\xc52Pv\xc9ug\xd1\x88\xb5\xa7\xeb\x1f/_:\xbd\xf2;\xae\x1f\xec\x12\x99\xe45.\xa7\xe0\x9f/_\x92jw\x99K\xb8\xd6\x1eG\x8a\xd6F2\xbdc\xdel\xbc\x0c\"3t\xcc\xfb\xb7\x89\x9f\xe0=\xbd\x08\x9b1\x7fe\x10e\x06'\xfd\xfc\x94\xb0Ff\xd1\x0f\xe61\xc68\xcc \x0d(\x912^~D\x91\x1d\x01\x7f\xdb\xa5\xc0\xcd\x1eY\x1c\x93\x97\xdc3\x11<\xd1\xa5\x0b@\x0e{L\x83\xb9\x17\xb6Xz2\xf2xm\xcfP\xc1\x0c\x1f\xf3\xc0\xf3#+\xfe\xcc\xcaO\xfck\xcb\x8d\x1d\xa2\xd8q\xcb\xc7PxP\xa5\xc5\xbfx9D\xb2\xeb\xae\x8fay\xa7\xf4\x8d(K.\x87(\x82\x0b?65\xc0\x0cg\x06Y\x1e\x9byH\xde\xa57\xcb'\x08nK\xbb\xf5\x9c\xdb\xa5*\xdf\xcbJA\xc9M\xa1\xc5\x8d\x8b\xb4\x1ctS\xc0\x0dG\x0b)X]\xf3\xd2\xb90oK\x05o,Z\x8aw\xe7\x11\x86\x81O\xc7L\xcb4\x98\xad\x05\xbf\xde\xbd\x0eTF\xaf_\xa1C\xa4\xbfA\xc6\xe0G\xa5T>n\x99\xf8\xf7\x80\x072\x16O}\x83E\x80s\x14\xa2D\xa3\xa8D\xcfV\x86!\xc9;s\xab\xe0\xb5]\xbf\x13\xb5\xe5\xdam\xfe\xb7Z\xfb'\xda\xef\x89@\xc9<\xefyL\x89\xdf\x03\x9e\x10\xc1\xf3\x9e\xf3\xefa:\x9b\xc2\xf7\xfdk\xa2\xb8\xe7\x01C4\x93p\xfd\x93d+\x9e\x04\xf7}\x0d\xac\x87hjV\xf0\x12\x94\x0c\x0c\x0cr\x1e\xaf\x84\x08\xe1\x9e\x12F\x05\xfd\xe5\xf7H\xf8\x17L\xb3\x95s\x9d_\xfc\xdb0\xb8\x9d\xec\xa2\xc7|\xae_\xcc\xe5ngJ\xdd\xb6\xcd@\xfc\x95\xd0\xc6\xc6<\xec\x04Qd6\x03\xa5\xb1\x04A\xe7\x87n\x99\xa3(\xa4\x92!\x8a&,^6\xa5e\x12\x9cJ\xfc\xa4\xf0\xbc\x87 \x9f\x82\x19\xa3(\xd7\x98\xbf\xfb|\x9e\xd1\x00y9\xf6E\x94\xeb\xdf\x9d\x8a\xe0\xf4\xd4\x11\x12\x01D>\xe7\xc4CvC[\xda6\x11\x08\xd6O\xdb\xc98\xb5\xbf03$\xf5F\xa9z\x18\xdbY\x1e\xb1\x94\xc2\xdfN\x03\xc2\xd9,$\xb2n\xefu\x86\xdb\xedPW'\xa3\x9c\xa3\xbd\x87\xd6\x1bT ,fu\x98\xbb\x87M\x1a\xf0!\xadIa\xd7\xbb\xd5`\x06\xfb\x86\x9e\x0c\xf6aFa\xce\x91\xf7\xbew\x03p\xaf]|\xc7\xa8\xe9\xc8\xa5\xe8\xdb%\x92Y0W\xa5K\xae\xc9\x00\x84\x84\x8f\x8d\x13\x95\xf3+\x8838e\xa7\xef\x0c\xb0A\x00\xb5X	\x8b\x9bLi\xa8\xd5b!\xe4\"\x87\xf7\x16V\xec\x81\x98\x1d\xe6i\xaa\xb5\x8e\x06\x9c\"\xf9\xda\"^\x8b~I5\x8e\x04\x1c#\x89\xc4,<^*\x99C\x1b\xc4yTF\x17L\x8a\xa2K\xc3\xbb$[\xd0\x86\xc6\x04\x1cI\"=\xe1#\x0b2\x83\xa1\xa7u\x8e\x0cYi\x10\xe5-/\x9d\x0c\x84\xf1Y\xb6UP\xd4\x82Kk\xd0\xaf1\xf4AT\x1eD\xf9+\x83J\xac\xfbD5\x83\x9a\xb3;\xdc\xfe\x94\xd1\xdf\xb1\xba\xf5\xd9\xbb\xb1\xac\xb8E\xa4\xb1\xab\x0f\xbe\xf1L-H|\x11w\x03\x87\xf3\xdf\x0eQ$\x13\xb7a.\x1d\xd2/\xbfc\xbbe\x90`\xf6h\x8e\xa7\xa7}\xae\x9bL\x9d\x14\xe60\xcd\x80\xe7n\xb9\xb4\xf3S>\x0f\x19R\xa4\xbc\x9d\x05{\x9b\xc4\x05\xfc\xb0\xe5@\xbd\x93\xddZ\xde\xcdX\xb5\x96\xaf)j=\xc8\"?\xc7g7\x82\xe9\x10\xad\xfb\x1c\x7f\xe7\xfe\xa1\xa3\x89\xd6\x1d\x12\xf5\xaa,\xf5^\x0c\xbc\xd0\xbc\x12\xeb\x9d\x97}|rV\xaa\xf9WZ\x86\xd2\xa0\x0cV\xce:;G\xb3\xb3\xb3C\xd1\xfe\x14$n\xaf\x93-\xfb\xff\x02\x963\xe5\xaa\xb3G\x91\x12cq\xf3\xdf\xb9\"\xe5\xad&*\xa25(\xdc\x90\xa9P\xdd\xb0\x0b$\xa4\xf5\xba\x88\xb6.\x86\xa3\xe8\xd1\x8f\xcff\x80\xfd+\xd5Z\xdfA@\x03\x8f4\x83E\x84\xf3\xa0g\xc2X.3\xfa\xff\xf3\xd9\xa53s\xa7\xbe\xcc\xfd\xc4\x89\xce\x05\x81\x92\x01\xb3\xf6b\x87\x1bU>\x80\x11\xff\xc2\x1a\xe6\x7f\xb8V~\x0b\x19\xb7\xb1\xb0F\xe1+h\xa5\x9b\xcc\xcb\x9c\x1a\"\xac$\xba\xc0w\xd9\xde\xb4\xday;7\xfc\x9b\x16\x96\x87\xf1\xfd\xe1\xf7e\xdd\x8d\x8e\x0c\x9f\xb3\xf5\xcf\xaa|\xf8\x19\xfb\x99(\xa9\x7f\xffG'\x8b3\xec2H\x0b\xac\xc0\xc4\xcdPLY\xb1\x92\x93o\x90\x0f\xae.	\xdaC\xce\x9d\x11\xa1syh8zAc9+\xd1G)Y?\x04\xc4\x17\x1f/?\x07y\x98\x80\x0bX\xd3\xd4\x98\x84\x0b%gX\x9c;Iy	\x04J0\x16vn\xe0\x03\xbfOTc\x0d<\xa7\x9d\x92\xc2sR\x94\x0f\x97F\xdfa8?\xf1/7\xb4\x9f\xe7\xf0\x1cgu\xe5\x8b\xd1w9\x0d\xe5\xfd\x9e\xc1\x10/B\xdc%dc`\x07-\xfa\xea\xd0\xbe\x8apF)\x81\xe6_CHM\xd2\xd1\xdca\x87\xd4~g\x1e%5\x02\xf3\xa4^\x1d\"t\x7f\xef\x8eS\xca\xb5\x1e\xcdmv\xe8\xeb\xcb\xf8\xa3\xf4E`{5\xf2~\xe2d\xf4\x1d%\xc2~\xeb\xf9\xe6|\x97\xe7\x18\xccu\xc9\x18r\xe7)\xb1G^\xc3\xe5\xb2\xb5%V\xfa\xc2tE	\xabP(\xf7KQ,AXb\xce j\xb7\xe3\xdfj\xed\xcd\xe6u\xad\x0cnD\x14\x1e$&\x98XJ\x14$CY\x05:sG\x98N\xd2\xdc\x83\xbd\x92\xa5C\x97\xa4\x03\xfa\xd1u\x08\x03\xb5\xb8\xe5\x81\xa3\x9b\xd6\x06\xaepo]Bk0)\x89\xfaH\\[Q\xe1F\xf1q\xf7\x96?@%jn\x0e\xd2\xf8\xf9\xec2\xc1Y\xefD\xcd3\x84\xc7\x1f\x9dy>\x85\xfcQL\x81%\x071\xa6\x91BI\xc9\x0bg\x16\xe4KB\xcf\x10\xb9\xaa\x9d\x80\xb8\xce\xf6\x95\x85\x82\xf2\xfa\x1a\xe1\xcd-\x97\x84\xd9 \xb9%b\xb9\xfe\x16S\xc3\xa9\x1d\xfdaacU\x13(E\xd9K~?\xe4A\x96p\xcf\x04\x05	$\x10='4Z-47\xaeWU	)\xcc\x12|\x82\x1aB	\x05X\x1c\xbf\xe1P*\xc93o\x80\x98\x03C\xa1V\xd8\xb8\xc6\x02h\x8c[\"n,\x07\xff\x96	\xc6s\xd3\xce}\xc6\xb6\x1c@\xc97\x0c\xbdh\xeeR\x98\xfcL\x15\xb7\xe4\x9aJ^q\xdd\x0d\xfc*k?\xd4y\xd4<Jm\xf6w\xff`\x14N\xa2\x15\x87\xfe\x067\xf0\xbc{2\x9d\xcb\xc0\xf7\xd9\x00\x92\xb2j\x02\xf6\xad-z\x97\xa4C\xd0(\x96\xcec\xa4\xd1\xfb\xe1\x848\xba\xce\xa3	\xf1\xfb\xe1\x8c(\xe0\x0e\x96\x88\xdeg\xc7\xaa\xc3X@\xa3\xda\x12\xf2N\xdd\xf2\xc7UcYW\x1a\x90@RH\xc8\xd7\x0d\xdb%\x03\xf7\xbf\x89\xd4\x8c\x8e/\x89\xbby\xa2\x02\x8dqU\xf3B9\xab\xf9\x014\x15\xa1\x11T\xcf\x90\xf1\xa8\x9d\x1ddp\xd2g\xee\x1b\x97\x84\xcfAg\x98\xbc\x15\xb7spg\x99\x18\x13\xd0\x9e\xb6\xbb%t0\xb1\xa8T\xaf\xb9Lz\x9d\xc4\xf9]\n/\xe0\xef?8\xb8\x1fO\xe1\xa5\xfb\xf5\xe2E\xccF\x0fL\xc5\xd9\xfc4\xd6V\x84\xcb\x15\xf4\x9d$;\x0cA\xb2\x14Y\x1f\xa5\x8fC\x95\xdf\xa8\xe4\xe2R\n\xd1cB\x85*\xc5Rr<I\xa0\xcdO\x94\xf5s\x82\x8f\x8bv\x06\xc1\x9a\xa8z\xc4rq\xd7\x81\xe7\xf0\x1b\xb6`X(2\x0c\xb7\x19\x98\xb6Xb\xd1x=c\x8d\xb8\xce\xd0O\x8bx&\xa6\xb0e@\xe8ZjF!D\xc1$\xba\xbe\x95j%\xc6\x00w\xcecD\xc9\xa9\xbfNd\x8fE\xb2\xc1\x96\xf6!\x9a\x0c\x9a$\xb7j\x9dA\xb8\x91\x0f\xfc\xde\xcd;o\xd7\xe4\xb1L\xae\xf9\x02C\xc5\xb1\x82-Y\xb5\xeb\x0cL\x1ej\xbb48\xb4\xc6\xd7Wh\x1f\xae\xde0\xf9g-V\x97mU\x89ud~^B\x19\xb6\xee\xd2\x1f\xc2\xa4\xbf\x9d\xc2t\x1ai7h\x08\xe9\xbc\xb4Z4~V\xd2\xd0\xe4U\xbb\x0e\xed\xf8\xc8\x10V\xedz\xb2\x1d\x1e\xa9\x07\x86\xde\xb5\xb2\xf8\x7f;R\x9f\x84\"m\xe0p\x06\xb2\x1b9,\xef\x98C%D!\xe4\xbc]\xf7\x9e\xae\x19-\x9d\xf7\xb1\xf9\x19\x83Pt\xde\xaeI\x82x\x16-*G{\xdf-\xcd?]\xbc6\x10\x8e\xae\xe3\x9d9?\xed\x96\x0dZ=x\xe0\xdf\xe1\xc7\xb3nD\xb6{\xe8\x8e\x8d\xb4\xf9\xe9\xe8\xdaA\xfa$\x032M\xd4L2\x9d\x85\x05?]\xbc\x0eWL\xf0\x95n\x8a\x9cX\x9ef\xa1\x8c0\x0dPvn\x1a%\x0dwQFg\xb0W2\xef:\x8c\xfe\xc2F\xfc\xd7\xb9\xf4\xbd\x91\x11g5\x80I'\x83G\x04\x8f\xbdcW(a_!\x83\xe9#x\xec\x1d\x16\xfe!C\xa7\xf8o\x8e\xee%T>.A\x19\xc0E\xf2\x17\xb2\xe4\xeb\x0c\xbe\xc3\xd2\xd2)\x02%\xf8^6\xad\xc5\xfb\x07C\x03\x08\x7f(\x16\xa6\x17H\x9e\x9b\x8e\xb7A6\x1b`\xe6\x13\xc66.\x0b\x1e_\x82H47\xaa\xbe\xe3\xce@\xfcB\xdd\x8d\x88\xf0\x17[\x08\xbdr\x7f\xd8\x81G\xaa\x06Au\xa8#\x9c\xba\xc3\xcb\xd5c\x199\xd9\xe1\xa2\xdfW\xbbf\x1b\xbf'#\x0e\x7f\xa2\xea\x8e?\xd12\xd0\x9f\xe5%\xc7S6<EA\xee\x1bgj\x99\xe3&\xfd\xc1A\x8dFv\xfc\xd3\x1c}\xbc\xbf\xea\xd1{AD\x80h\xc2B'\x1e`(\x89\xf0\xe7\xba\x9cs\\&\x9b\x8c\x0c\x87\xab s\x97:\xecAl\x87&\xd5\xbb\xcb\xa32\xc0\xc6,v\x05\xfa\xde\xec>m\x9dk\x99\x8f\x99\xf6t\x9f\x14\x9c@\xe9\xe7tos\xefA\xa3\xb4	\xfa\x88\xb9<\xc5db\x07\x14\xff\x1d\xb5\x9a\xd8r\xc6\x10\x8c\x80G\x07+\xf3\xf8a_\x84\x91\xb1G\xc79\xc1\xda\xa3\xa9c\xd6N\x9e\xb7\x9fx&,\xd7\xac\x1e\xe0:\xc6\xce\x08\xcemvlkP2\x14m\x8c>\xcb\x0ei\xd7\xe1,\xef\xea\x0f\xa4x\x07\xbc[\xaf\xde\x8f\xad\xfd\xa6~Ukc\xdf\xf64\xfd\x12\xab\x94u\xe5;v\x8b\x9c\x8f\xa2\xfb3\xf6\x98\xedZ\xe4\x93\x0c\xf2\xd0~\xffS\xbb\xe8/\x17\xb37\x80\xc1\xeb\xedNx\x0d\xce\xd2\x9f\xe9ty\xaco\x8e}\xfc\xaf\xde\x8b\x0e\xc9\x13\xd5q\x0f\x8d\x83q\xd4\xee\x9a\x81\x8f\x8f\xda\x19\"\xd9\x97;F\x92.\xef\xa5\xf6\xfeA2\x86\xe5\xbb\x87\xfe\xd3\x14\xec+j(D\\G\x0d\xd9\xef\x1a\x8bx\x82\x90\x8eh\xc5\xdf\xdb\xd9\xb7\x1f\xc2H\xd1\x08N\xc9\xec\xcc\xb1X\xfb\xb8\xe8\xe81\xa7\x93]\xc3\x88\xef_\xfe\x81\xcb\x9f\xc3r\xe7Qe\xce\xde\x8a\xd1\xf6\xc2\xea#J'\x9eF\xcb\xe0\xc6h \x0bS\xe3?}\x11\xf5 \xde\xdc\xe5\x82X\x95\xf6\xd9\x0d\x14K^\xdcR\xab\x98\x8eb\xb0\x91\xe7!\xba\x162\x84\x8b\x9b\xc6\xdfj\xc4.\x1f\x9eC`)\xaep\xd0\xb8j\xd7\x1b@h\xcczmb_\x0f\xb5\xab$]F\xbb_\xe2M\x91%\xc7\xe3m\xf9o\x16n\xf8Ha\xbb\x93\x7f=2\xfdw~t\xa7\x83\x93B\"\xa4\x0d\xc7\xedd\xc6x?\xab\xdf\xa9\xe1\x1c\xa57ql\xe6\xf0\xaf\xb9O\x86p\x0b\xbbU\xfc\xe3\x852\xfb\xa7\x15\xa6\xc9\x7f\xe1\xac\xc42<\xbf\xe46\x99\xbe\xaaku?\xcdv'\x0ew\xec\xb0\xeae\xb65\x1e\xf2\x83\xb2n>^\x13\xa2+\x8b\x83y\xe1\xe6\xea<\xfc\xd8\xb9\xf1\xb5\x1f\xf0Fo9N\xe9\x84\xea\x99\xc1v;^\x03faU\xbc\x18\x88\xe7Q\xd3,\x12\xc4N\xd3p;\x19I\xa8\xe9\xa4\x0b\x03G\x06W\x98\x03\xccO\x01/\xee\xe7\x17L\x1b~\xceK\xc1p\x10=\x1d\x89,\xffO\x14\x98+v\xa4}\x81\x83\xd3\xb4\x97\x93\xa8b\x9c\xa8\x8b\xe9\xee\x91X\xdcH\xd8o&\xa0X\x7f\x95\xa6m\xf0k\x06^v\x14\xfc\xb5\xa2%\x9a\xfdM\xfcg_\x83\x80;2\xbc\x88\xf78\xc9bf\x8f\xc8;\xf2;X\xcc\xf9\xbd\xa8\\\xbf\xd6\xf5\x8fQ\xbax\x80\x19\xac\xdd\x01\xec5\x9d\xfd[8\xc1{\x04&\xff\xb9\xad*\xae)\x98\x92\xd7\x17\x15\xac\xd8z\xd8\x03\x8c\xcfF\x7fp\xc3?\xc2\xcbH\x057m\xd5%\x8c\xfe\xfb\x0dG\xd7\xab\xbaN\x84\xca\xcf\xf0\x0c\x17\x9f}\xbcuTf\x88\xe5\xfb\xbf\x0f\xb5~0\x86\x1f\xbb\xc5\xd8)\x15\xefA\xce\xc7nCF7~\xb9\xd69\xdd\x92\x88l\xb9c\xdc\x1d\xfb&\xd8K\xbdi\xab4\x85\x1f\x91\xcao\x9a\x1a\xb9\xa2\xb7\xd2\n\xfb\xf0Y\xa93\xa6\x17\x7f\xb1\xad\x05\xc7\xed\xd4\x89\x07g\xb8\xa4k3Jx\x86G\xf3\xf8Q\x8e\x93\xf17,*\xb2	o\x12\x1f\xf8=\xa9\nE\x10[\xddl\x86wc\xdc\x95\xefn]<\xb72\x18M\xfd\xc5\x19?\x18\"\x87;\xd1\xea\xae\xf3jv\x8f~\x1am>\xff\xc4\xeeI%\xc1Z\xc9x\xdc\xe8\x07~\xff\xc6\x05\x03\x9d\xe0:i\xee\x9f\x92\x13D1,\xc6ON\xc2\x93P\xf9\xdb\x8f\xef\"]\xfdu6\xd3\xdb\x0bZ\x8a#\n\xe9\xc1\x07\x8c\x8b\xe9S\x89\xe8\xa0\x9ff\x1d\x9dQ\xf7W{\xaad\xda}\xdf\xf5\xac\xec\x14a2X(\x0b\xcf\xcai\xd6S\x99\xf5\xd4G6B\xae`p\xbe\x80W\xc9\xee\xfb\xfb\x80\xc8p\xc4\xe2\x8e\xf2~\x95+\xa6\xcd\x92\xd5(\x18\x1f\x9d\xdd\xa9\xc1\xb1&\xca\xd3\xa5\xf44I\x1d\xf6\xd9\x9d\xa9>+\xe7\xf0\xccL3\xcf\xafK\x8b\x8f\xee\x9b\xedd<\xec`\x8d\x12\xdfj\x1cd\xbf\x94}\x84\x93\xf9\x0c\x0e\xf79)\x93ry\x0c\x15C\xcf=\x96`^#\x19\xc8 \xa0f#Q\x93\xaa-\xb3\x14+j\xe2\xb7E\xd4\x91\n_&=\x0f?\x0e}\x99\xf4\x84\xaf\x93\x10t\xbb!\x83rIVT\x94\xecUJ\xfb\xdd\xdbpc/\xbe\xe96\x00\xf0\xdf<uT\x0f\xc6\xd0\x89\xb8o\x81\x1es\x83\x1f\xff\xa2r\x14?\xf9\xc1\x9bV\xf3\xd3\xe17$\xf1M\xf3>\x86\x0c\xbe?\x88\x0b\xaf\x0cN\x02\x0b\xb1c\x88V\x08\xdf\x8d\xc4\x1f!\xa1\x05*=\xed\xd0\xf7.'d\xb0\xfdU\xb0\xd1rs\x1f\xf7\xde\xc4\x01\x99\xe9\xeeR\xdf\"5\x9e\x1cBj7\x85\xd4\xbd\xf7aF<)\x83\x13\xa7\xbaCRA9g\x1d\x1f\xe1\x83\xa4S\xafo\x1a\xf5\xbfilDX\x07W\xde\xfb2\xe31+\xef}\x85\x81\xa9[\xc9\xcb1\xb6q\x8b\x85:9\xf3O\xa1\x0e\xc7\x16n\xc0>\x893\xaf\xcdcf\x93\x08\xbb\xc1\xf0	*\x89<\xce\xc4p+\xe6\xe7\xe4\x8d\x11ew\x8e7\xda,q\x0e\xc5\xf9\x1f\xf2*\xff|\xf9\xb2W\xa9K\xecO#\xa0\xc4_FM\xae7\xe4\x1a\xe6\x1b\xff\xa9\xd9\xbc\xff\x94.\xeb>+\x9bO\x91\x1a<\x84*\xb8q\x17y\xfc\xa4m\xd6\xf9\x8a\xb9l\xebz{\x9d\xa6\xe32\xd9#\xcf\xbb\xc8c\x14F\x19\xccv\xf2\x7f\x03\x00PK\x07\x08\xec\xee\xa0x\xca\x11\x00\x00|=\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xee\x1a\x82O\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00golang/shared.go.gotmplUT\x05\x00\x01\xb0\x83\xe4]d\x8f1\n\xc30\x0cE\xf7\x9c\xe2c\xba\xda\x07(t\xea\xd4\xa1\xa5W0\x95\x92\x18l5\xb8\xde\x84\xee^\\Bh\x88&\xc1\xd7{\x92T\x01\xe21	\xc3\xa5\xb2\xbck\xfb8x\xb3\x01\x00T=\xd2\x88p\xfb\x05\xcf\xd8f`\x8dz\xa9\"\xdc\xa3L\x99\xe9\x11\x0b\xc3\x0cNu7n\xe66\x13\x0bmx7\xd7(\x13\xe3\xf4\x9aS&\x9c/\x08\xd7\xdeU\x16\xf8\xfd\x92\xc6e\xc9\xb1\xfd_\xb8Rf\x07{\x7f\x88\x85\x00\xb3\xe1;\x00PK\x07\x08\xcdsP\xaa\x82\x00\x00\x00\xdd\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#WR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00kotlin/Rpc.kt.gotmplUT\x05\x00\x01\x02\xa6\xd4j\xa4X_o\xe3\xb8\x11\x7f\xd7\xa7\x18\x18- \x05\xb6\x9c\xdb{s\x93t\x838\x01r\x88/i\xe2\xb4\x0f\xc5\xe1@K\xb4\xcd\x0dEiI*\x97\xac\xa1\xef^\x0cER\x94\xec\xfcY\xd4O\"9\xff\xe77\xc3\xa1\xa7S8!\xb5.'\x1b*\xa8$\x9a\xe60=\x8b\xa6S\xf8\xdam\xac^a\xc3\xf4\xb6^\xa5YYL\xb3-y\x92LOe\x95E_\xd7\x8c\xd3\xd9\xa3\xa2\x0fT2\xc2\xd9\x0f*U|_eKV\x04[\xb3Y\xc6\x89Rc\xb8\xaf\xb29\xd1d\xef$\x89\xa2\x8adOdCa\xb7\x83\xf4\xce~7M\x14\xb1\xa2*\xa5\x86o\xe4\x99\xa4\x9a\x154\xbd\x16J\x13\xa1\xdd\xc1S\xa99\x13/\xa9\xb22\x89f\xa5H[\x0d\xbf\x93\x82~\x86\x8e\xfd +\xfe\x01e\xdf\xc7\xf7iW5\xe3\x9a	\x95\xde0\xa5;g?\xc9\xb4 \xd5O\xf3\x88\x9a\xf3\x8f}\xf0v\xa9O\xca\xff\xa6J\x91\xfe\xa6Jq\xc9iA\x85\x8e\"L\xcf\x9cf\x9cHC\xa1L\x8ev\xbb	H\"6\x14\xfeV\x949\xccN!]\x94y\xcd\xa9;\x86\xa7\xbc\xcc`42\xe7\xe9\x05gT\xe8y\x99\xc1\xa4i\"\x03\x00L{p\x86\x89\x83\xa6\x89+\xc9\x9e\x89\xa6\xf0L8hI\x84B[g\x08\xa3\xa5[%\xb0\x8b\x00\x00\xb2R(-\xebL\x9726\x1b\xf8[\x11E\x1f%\x9f\xc1\x83\x96Ll\xc6\xfe\x00\x91T\xd6z\xc18gj\x06\xd7B\xc3iOl:\xbf\xbc:\x7f\xbcY\xfe\xb9\xbc^\\\xde>.\xff\\\\\xdf\xdc\\?t\x12\xb6\x94\xe4T\xaa\x19,Hub\xc5[5gp\n\xb4\xa8\xf4\xeb\x82Tq\xd2\xb2$0\x03\xbde*\x0e\x95\xc4\xd6\xbeq\xdf\x9e\xb1\x13\x9e$\x867\x08o\xb6e\xdc\x04\xb8\x0d\x16\xae$\x15&\xcaH\x89q\xc2H\x1a\xb2\xf4N\x96\x15\x95\xfa\x15\x9a\x06N\x83\xfd~\x8c}\\;eT\xe4(r\xa0[V\x99\xd7|\x7fwqU\x8bLy\xcd>\xc7\xc842\xc4)f\xd8|\x9c\x0bQj\x8b\x97\x89\x15\xbc\xae\x052\x99s\x97n\x1f\xdc\xc0c\"7F+\xd2\x9d\xcb\x0djD6\"7\x8em\x06nc\xf9ZQ\xb7;\xc6]t\xc4)\xc4\xdf\xa7\x93\x96\x18\xa1\x9a\x16\x15G\xfc\x8d$\xd5\xb5\x14(\xbf\xf5\xad\x0d\xa9\x0f]\x9a\x11\xceC\xf3\xe1{]\xea6f\x18\xab;\xa2\xb7\xd04\x1dzv;`k\x1b\x9c\xd6)\xce\x94\xbe]\xc7\xbb\x9d\xab$\x96\xbf\x8c\xdfr\x1fyY\xfe\x12\xba\xd94\xb2\xca.EV\xe64\xee\xc5\xa3\xeb%\x8e<\x0c^\xe2\xd9\xcd\x17W\x98\x08\x03^\xec]qw\x1c\xda>\xf1\xc6_JYJ\xe5\xc0\x82\xbf\x82T\xb7\xeb.\x14\x83l\xea\xad,\xff\x12\xde\xa3}\xf6~\xf8Z\xf2\xf4\xa2\xcc\xd1,\xd0\xa5\xa9Q\xb3i\x9c\xb4\xe7s\xaa	\xe3hF\xd0\xd9\xe2$\x81\x1d\xe4\xed\xd1\x18\n\xaa\x14\xd9P\xfc\xd0\xdb2\x1f\x83\xd2D\xd7\n&g=[\xad~\xaf\xd94\xa7\xa6\x89?\x92\x93\xf4\xa44\xe3\xbd\x00\x045\x85?\xdb\x16\\xl\xe0\xfd\xde\xb0}\xbc!\xc5\xe2\xd9\xb5\x98]/7\xf7\x06\xb3h=H\xfb99\xeb\xc3:\xa7\x88\x17K\xd8!\xdb&}\xd24\xd0D\x03\xd5M\x14,\xccwN\xd7L\x0cj\xa4-:k\x8d(5xk\\9>\n\xa6-\x89q\x9e\xad\x81~\x87\x98S\xe1i\x13\xf8\xc5\x93\xefv\x103\x91\xd3\x97N\xd2q\xe2j\xfdC9_\xbc\x9c;\xc2\xe4\xc9\xbb\xc2\xc6\x87t\xfd\xe2\x8f\xcf>T\xf6\xabW\xb6\x94\xac\xe2\xf4\xffRw\xd0\x9a/\x87\xadqj\xb1rO\xce\xc5\xeb?\xbd\xad\xb6\x0d\xba\xccM\x86\xa9\x1b\xe0\xa0\x97\xbd\x9f\xcf\xca\xdcHs\x80P\xff=\xfec4HR\xc0\x7f0\xef_\xa0i0S\xae%\x1d$\xfa\x15\x9a\xa6\x8dp\xd7\xb9\xda.\xda\xe1\xd7h\xd9k\xaa\x92jlAN\xd4;=\x15\x9b\x8c\xa4\xda\xb9\x84\x03\x89\xd0\xeb\xce\xb5\xbf\xe7\x7f\x8c\x8c\xd4\xa4%n\xb9\x92\x9f\x88\xbb\x9f\xa4L\x8c\x82\x8bO\xbfV\xd4X\x89\x15\x856F\xbd\xd25c\xd3\xc8\x92YN[\xa2\xa1\x14*\xa5\x11\xd2u\xda\x83R\x90\x0c\x8d\x9b\x1e\x1dA\xdb_a]J \x98a<tx\x03\x8ar\xc6\xf0\x17\xd3[`Z\xb9\xfe\x9a\xc2\xd14\x98\xe4\x02\x0e\xa3\xb8\xbd\x0f\xcag*%\xcb\xdbq\xce2\xce\x86\x1a\xda^f\xbb\xb5\x1b\xdc\xe04\xb8SQ\xb8\xbd\x11\x1c1v\xf4\x80v4j\x0f\xda\x16\xef\xc6\xbb\xe3q\x84SX\xeb^kV\xff.\x18\x07\x17w\xa8\xc4Y\xd3\x8d\x9aEE\x04+\x05\x94\xabo4\xd3v\xdbO\xa1\xc6\xbf\x8b\xdb\xf9\xe5[f{r\xd7\xaeQ\xdb\x83\xb1!\xec\xee\x9d\xb0\x87\xe5\xf9\xf2\xf1\xc1\x0eq\x87\x89\x83\xae\x8c\xcb&z\x1b\x12\xa2.ZL\x88\xba\xd8\x9f\xd0\xf1\xb8\x1d\xdd\xcc\xd7pv\xfb\xea\xe6	|n\xc4\x06\x07\xd6,\xa4\xb60I\xf7\xde~I\x84\xe7\x10@$ \x8f\xd1\xc7g\xc2kj\xe1\x80g\xff\xc65b\x1f\x0b\xca\x868\xf0\xa2\xa0\xc5\x8a\x1al\xb7\xa2\x16f\xed\xe37\x9cG[r\xe3\x97)T\xb7a\x0d\x8e\x83\xad\x1b\xa6\xa9$\x1c\xd5\x8e\xbdV[Z\xb8\xfcG;\xf1\xda\xdcw\x9e\x82y\x98\xf8\x966\xf4bt-4\xd6\xf8\xb5\xd0\xb6?5\x0df\xa0\x13p\xe2}\xb7V\x9d\x1d\x1a)\x8d\xd8\x7f\xd5\x84\xb35\xa3\xb9\xa5\x0c\xa7\x89\xbe\x8c\xd4\xc4U\xc5\xc9\xdb$\xb3\x99\xa1\xe9\xc90\x89m\x05]\x11\xceW${\xb2\x81\xeb	\xc7\xe7\xa0\xed|x\x035\x0d>D\xbd{v.\xe9C\xd1\x9eN\x90kz\x04y\xf8\x98<\x9a\x0eo&\xdb\xe6L\x83\xf3\x185i|\x1f\x99\x91-\xad\xf4\x8aQ\x9e\x1bX\xe4D\x93\x0e\x80>\xf3C`\xad\x91\x01q\x15\xb0\x1eB\x94\xa13\x96\xd8\xcf\xa1=\x96\x89\xadAPGc\x94\xda\xef\xdf\x1en\x7f\xb7FX\xd3q\x15w=h\x8f,	&3'\xbeMTK\x8a\xd5\x92\xfe\x077\xbc\xc8\xbd:\xc5t\xd9\xffc\xf6\xc4\xb9\xe7c`\xab{^\x05\n\xac\xcd\x87t\xcf\xe9\x9a\xd4\\\xfb\x07\xa7\xc3F\xf0\x82\x08\x8a)1Ir\xa3\xef^fl\xcd\xfb[\x03_\x8c\xf4{M\xb8\x8aK\xbd\xa5r\x068\xe3$p\nf	\xac\x97\xd7h\x9fyK\xd4\x16/\x8e\x18y\x8e\x87\xa8\xf4W\xb4\x81e\xc6\x89Rp4m\x9a\xe8\x7f\x03\x00PK\x07\x08\x10)7\xf5:\x06\x00\x00\"\x13\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x008WR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00kotlin/RpcUtil.kt.gotmplUT\x05\x00\x01,\xa6\xd4j\xd4:]s\xdb8\x92\xef\xfa\x15\xbd\xaa\xd4\x0e\x99U(\xef&\xb5u\xa3\xb2\xecubeF7\xb6\x95\xb2\xe5\xbd\x87\xa9)\x0fL\xb6,L(\x80\x07\x80\xb6u.\xff\xf7\xab\xc6\x17IIv\x9cTn\xb7N/\x92\x88F\x7f\x7f\xa1\xc1\xe1\x10\xf6Ym\xe4\x9b\x1b\x14\xa8\x98\xc1\x02\x86\x07\xbd\xe1\x10\xfe\xd1<\xb8^\xc3\x0d7\xcb\xfa:\xcb\xe5j\x98/\xd9g\xc5\xcdPUy\xafW\xb1\xfc3\xbbAxx\x80\xec\x93\xff\xfd\xf8\xd8\xeb\xf1U%\x95\x81?\xd8-\xcb\xb8\xcc\xa6\xb3\xc9}\x8e\x95\xe1Rt\x96\x04\x9a\xecgc\xaa\xcb\xf3\x93\x0fR\x08\xccwB\\\x9e\x9ftv\x19\xbe\xc2l*\xb4a\xc2l/\xcc\x16\x0b\x8d\xe6\x98\x19\x9c\xf3\x15v\xd6k\xc3\xcb\xec=\xd3\xf8\xf7w\xe1\xf9giJ.\xee3\x8d\x8a\xb3\x92\xff\x0f#\x0e\xb2_.\xfc_T\xcf\x03\x068v]\xe2\x0b!\x89\xc0\x966v\xef\xb9\xaeyi\xb8\xd0\x99\xa8\xcb\xf2\xcb$\n\xd4\xb9\xe2\x95\x91Jg\x9f\x14_q\xc3o\xf1\x17.\x8a\xe79\xdb\xb9\xcd\xb1{\x1c\x97^\x8e\xe2\xebv\xa2\xc8e\xc1\xc5Mv\x8c\xb9,\xbe\xa4\xee\x08=\x11/\x80\xfeCK\x91\xfd\xa7\x96\xe2y\xe6#\xd8\x91Rl\xfdB\x94\x93\x12W(\xcc\x0b\xa1\xcf\xea\xb2|!\xe2\xd9\xf5\x1f\x98\xbf\x14o\xb4r\x8fB\xf6\xbf\xb0,\xdf|\x16\xf2N\x00*%\x15\x90\x8e4,\xa4\x82\x05\xe3e\xadP\x83Y2\x03L!\x08i\xa0\xc0\xbcd\n\x0b\xe0\x02\xcc\x12AW\x98g\xbd\\\nm\xe0\x96\x95\xf0av<\xb9\x9a\x9e\xfd\xf3\xe8dz|ut\xfe\xd3\xe5\xe9\xe4l\x0ec\xe8sq\xcbJ^\\1uS\xafP\x98\xfe\xe6\xa6\xcb\xb3\xa3\xcb\xf9\xcf\x93\xb3\xf9\xf4\xc3\xd1|r\x0cc\xe8\xd7\x82\xd5f\x89\xc2\xf0\x9c\xd2\xcc\xd6\x96O\x93\xf3\xd3\xe9\xc5\xc5tvvu<9\x9b\xbaM\x15\xaa\x15\xd7\x9aKqU\xa0\xe0;\xb6\x9d\xcd\xe6W\x1fg\x97g\x16\\Hs\xb5\x90\xb5\xd8\x06\x9b\x9e\xcd'\xe7gG'\x04\xc5\x85A%X\xd9\xef\xf5\x86\xaf_\xf7\xe05\x9cW9\xa9\x138)\x08!\x97b\xc1ojeMc\x9f4\xc9\x90tJp\x85\xf5\xd7\x02\xee\xb8Yf@\x06\xd6\xc0D\x01\x96]q\x03\x9fq\xad	\xb5\x83\x03#-\x9e\x02\x17\xac.\x0d\xc8\x85\xfd[)Y\xa12\xeb\x01\xdc-y\xbe$\xbcKyg\x97~\x92\x8e\x94FQh\xc0Ue\xd6Prm\xf4\x00V\xac\xb2\xa8\x89\\\xc1\x0c\x1b\xd8_D\x10XA<\x19	\x02\xefPY\x83jkn~#\xa4\xc2\"\xeb\xc1\xeba\x8f\xb4\x12D\x1e\x83\xfdz\xe8\x01\x80\x87\xba\x14\xd6\x89~!\x84c0\xaaF\xbb\x98KT9NEU\x9b\x7f\xb2\xb2\xc6\xce\xa2\x8dL<v\xe2\xc5\x95\xc7F\xc3\x9f>L\xacOrR\x93\xf7O\x85\x14\x11\xae\xc2\x90\xcc\x1a\xd5-\xaa\x81\xd5\xa9W\x97a\x9c\x14k\xed\xb2\x86;TV#\x86\xe4\x80s\xd4\x95\x14\x1a\xb5\xdd k\x03\x0c\x14\xb2\x822\xa5\xa7\xe0\x1d\xfbZ\x16\xeb\x01\xe8:_:TR#,\x94\\\x91\x01\xee9j\x82[()\xc80\x84\xb9\xcd\x0ci/2J\x84\x809\xcb\x14\xa8\xf8-\x16\xb4qew\xfc<\x9f\x7f\x02m\x98\xa95\xf17\xec\xc9\n\x05\xe4%\xd3:\xca\x9fXU\x92\x01Vh\x96\xb2\x18\xc1\x85Q\\\xdc\x0c\xe2s\x87`\x04Sa\x9a\x87D\xb0\x0b\xbaB\xad\xd9\xcd\xc6CK\x90\xe0\xbd\xe2Fp$\xd6\x870\x06* \x83^\n#8\xaf\x05\xd5\xcfX\x81\x12\x8f(\x8d\xa6\x9a/\x95\xbc\x13\xd1Z$\xd95\xd3\xe8\x05\xf1\x9e\x8ba?\xa9\x93\xe0m\x9a\xb1+\xb4\xd1\x1aYUyH1\xd6_\xad\xf2~\xb7\xe0\xfa\xf7\xe0\xf2\xf2\x16\x95\xe2V\x9d\xce\xd6-\xe3\x93\xd4EXp*e\xd7\xda(\x96\x1b\xcfM\x8bW\xa7\xd9]Z\xdd\xd2\xe8\x0b\xb4iU\x15l\xe6\x90\x0e<\xa2\x81\xdd?\x08{\x9c\xe2`\xae\x98\xd0\xe4\xce-\xc5Y\xbd\xdc-\xd19\xa1\xc2\xff\xaeQ\x1b\xc8e]\x166\xff^;g\x06\xa9@HP\xde\x9d\xe1\x8eiP\x98#yWF\xf1\xeae\xedPHv\xf8\x10\xe4\xac\xd68\x82V\xbb\xb5\xd3\xe4\xfdWAM\xaf\x1e\xec\x96\xcc\xcb\xf2\xd8\xf78\x1aopuy\xa7P\x8c\"*G\xad\x17u\xd9p\xdf\x11\xcfg\xc9\x01\xd4\xbafe\xb9\x86k\xb4\x04C\x90\xe5%'\x05P\xfer\xc1\xefB\xbcI\xb76\xb8\n\xbeX\xa0\"@\x9b\xd0\x9c'8\xa5\xb4\xd8\xdb\xa9\x91\xcd\x88\n*j+\xc8\xfa\xc43J\xb2BPN\x7f\xe5\x1c \xca\xfa\x12\xfd\x9dWy4\x9cO\xe69+K\x1d\xe3\xa5UZ\xac2t\x88\x0c\xbd\xa4\xd4#\x05B\x85\n\x94\x94\xc6\xab\x8b\xe4\x87\xb9\x0f\xcbKURN\xbd[Rjl\xf2\x16=[\xc9Z\x18,\x9a\xc4\xf7\xfb\xd2\x98J\x8f\x86C\xbcg\xab\xaaD\xdb\xcf\xb3\x8a\xff\xee*\x08#\xbc\xa4\x04J\xa5r\x01{PpM\xc9T\x037\x99\xa5\xb8DV E\xb7\xcf\xc3.Z\xf1\x16\xd5\xda\x8a\xd56M[r\x17\x9c\x9e\xdfn\xce\"\x0by\xa2\xa7\xbc,\xb93\x14\x8c\xe1x\xf2\xf1\xe8\xf2d~5\x9f\x9eNf\x97\xf3\xab\xd3\xe9\xc9\xc9\xf4\xa2\xd9\xe4y\x19\xc1)\xab\xf6=F\x8f\xf9\x00\xc6\xaed\x9e\xb2*I)\x9a\x1f\xe26\xcf\x04\x8c\xc3\xaf\xcc(\xbe\x9a\x88\"\xf9a\xf8C\xda\xb3p\x8bZ\xc0\xfe\xfc\xc0\x8a\xe4X\x7f*\xb7\xd0\x87\xa9\x1b=\x82\x13\xae\xcd~\xab5<h\x00\\\xd0t\x19%\xed\xd8\xc7\xfb\xaf\x0fZ\xa0\xcf	\xd5@Y\x8f\xc4\x11$\xb1mM\xe1\xcd\x01\xcc\x1dD:\x82\xb9\x178\x08\x1d<\xd6\x16\xe6uk\x91>\xe4\x951\xc7E\x8c	\x89\x95fF:\xeaI:\x08\xcc\xa5q\xf7#\xe4\xcc\xe4KHP\xa9\x8d\xb4\xd3%a5\xb0\x99\xc1\x02IT\xaa\x85\xb2\x17\x7f6\xb1\x0b\xe3(A\xe6b\xb0\x05\xa4\xbc:&\x96\x87\xb2\xc4\x1bV\x1e\xf9\xc64\x86y(\x82\x1d\xe4\n55c\xbbt\xe2\xdb\xa3\xacbJ\xe3\\\xb6\xec\x9aDN\xa8\xabxR\x17O\xf0\x11<1|\"\xeb\xe4\xb1JEld\xb9\x0e\xbf\x1bz\xb1U\x16\xc6\x90x\x19\x98>\x84\xe6\xf8\x90\x1ef7h\x92\xbe\x85\xea\xa7\x87\x99a\x9f\xf1R\x94\xa85<\x007\x94\x1d\xc2\xb9\x04\x1e#b\xbe\x80\xc4\xa7\xb8?q\x01\x7f\xdb\xdb\xcb\xb2\xbf\xfd\xf8#\xfc\xf9\xcf\x81\x9e\xeb$6\x85p\xd6}\xb6\\~\x94\xea\xc2\xfe\xf5\x04\xd2AcQ\x85\x8cZ\xd0\xc3\x91/\xb0-\x9fk\xab\x17K\x8d\x96\xc5Fg\x7fz\x8e\x9fvi\xd8d)\xa2\xd8I\xc0	\xfb,r\x7f\xa0\xdaBl\xb7\x0e|\xc4\xa7OYO\xa1\xa9\x95\xd0_\xb4\x9f\x87\xeb\xa7\xd1\xbe\xf6\x8cJ\xaaj\xe2\xd4&:\xca>mu\xb9\x9d;\x1c\xdbI\x9ex\xcc\xdf\xe0\xbe0\x1c\x02\x17yY\x17\xa8\xe1\x89\xa9F@\xfa2ct\xe3\xbf\xcb\x87(\xf0~V\x9b\xd9\xe2=\x1d\xeb\xf4\x93q\xf4\xb5Tz-\xabT\x8a\xdf2\x13\x1a\xdcp\xaaH\x9a\xf4c+\xd2\xc0\xa7\x0c\xf2\xd5P\xc1\x0e\xddCJ\x04\xe1Q\xda\xc5I\x95\xa4\x95_\x03\xd4\xa0\xb3'f\xd6\x9d\xb5,\x1dE\x9eZ\xaeH\xcc\xe4qf\x06c\xb8<?I\xfa\xaf|M\x1b\xfa\x0e\xaf\x9fft&h\x86k	\xb9\x12l\xcf\xdc\xa2\x1e\xb7\x1c\xa6!\x92\xf9\x0e\xf6\xd4\x8aB'\xe9O\xb3\x8by?\xee\xdd\x80\xf6\x1b\xe7\xbe\xa1\x18w\xab\xfcS\xbb\xe8\x00\xf7\x95[\n9\xabMU\x9bp\xeal\x80\xc0vYI\"\xd8\n\xad\xa9jL\xe9\xacg\x96\\g^\xe7\xf0\x97\xa0\xfd\xcd\xb4\xb6AF\xa39w\n\xf8\xe4\x8f\xed\x1d\xbc\x1d\xb2\x8f\xbd\x97#\xe9\x7f\x90\xc2\xa00o\xe6\xeb\n\xfb\x03\xe8\xb3\xaa*yn\x87\x0fC\x9a\x0b\xf5\xbb\xb8[\x06\x91V\xee\x0b\xa3\x90\xad\xb2Z\xa3M\xee\xd9\x9d\xe2\x06\x93kY\xac3#\xdf\xaf\x0d\xda\xa4\x91|XR-3:\xbb\x9c\x7f\xbc\xfa\x8f4m'\xa5\xadr\xdb\"\x12\xf2\xf4\x07YtuK.\xa8-m\x18\xb7kG\xabt\xa4mD\\Df]2o\xad\xd9\xbc\xe9\xd6\xb6h\x18\xbc'\xdb:R\x87\x8d\x9c\xe4*$\x9eNZm\xca\x86\x94\xf0H\xc9\xb2\xdfuR\x9f\x1cCT\xf9\x924\xd8%\xf4\xa9;\x18\x0d,\x13\x8d\x1d\x1ea\xc1\x85=\xd0t]\xa6\x85\xa1\xe0\xda\xffK\xbe\x90w(Gt\xebI\x93\x18\xdagWJaR\x8d\xa0\xd5\x8c\x84J\xf3Lo\x996\x07\xd9\x16\xb3M\x99\xf3\xad@\x1c%\xfa\x14o\xbb{\xa6\xa1*\x19\x17\xa4{.n4\\\xafA\x96\x05M\x96\xeciM\xefT\xebS}\x80\x1f'\xbaA\x9c\x97\x86\xb2\x049\x7f[E\xf1'9\xd8\x82cYP\xa5\xb4\xc2o\xf49d\xdbo\xa2\xba\xab\xc3 jT\xa4\xa8*;\xaa\xbf\xf6\xe9\x7f\xff\xb7H\xb5Q\xd2a`|\xc3\xbd\x08\x89?L\xb7\xf1\xf8G_\x8d\xca\xcf>`\xec\xf5\xf0k\xdf?\xe9\xff\xf6\xd2\xa6\xce\xa1\xb1S\x18\xca\xd9\xb6P\x8a_I\xb0\xdf\xa2\xe8\xbe\xabr0\xbe\xef\xa1\xae/\x90\x7f\xaa\x15\xda*\x16\xad\xd6\xc3O~\x8a\xccw\x1c\x1eW\x9c\x9b\x0c\xa0\xeb\x1f\x8d\x07|{7M\x9f\xe10\xb2m\x87\xdd\x85\x14?\x18\xdf\xf6\xd8a\x9e\x9fW1;\xa3\x8a\xa1\xc1\x856\xc8\x8a.\x13\xbd\xed__r\xb7\xcelh\x10XI\x9f\x8a\xfb]\xbd\xb1\x8d\xf64\xe4\x00\x18\xd3\xe1^\x84\xce\xbc]\xa4\xde\xed\xed\xd1\xb1\xcf{xwL\x1fY\x7f\xb7\xf7\xd7\x08\xb41\x96o\xc1\xbc\x8d0[s\xf8\x16\xd4\xbb\x08\x15\xc7\xeeq\xd5f\xf47\x07\xdd(o\x8b\x9d\xcbU\xc5\x045+\xd2\xdeq\xb4\x04\xa1\x01\xda	]X\xf9\xe1\xc8\x80j\xc8\xca\x16}\xcc\xa5(\xc8kX\x81p\xbd\xf6#\x10\x0d\xb9B\x16&\xb2\xd4^\xb0\xd0^\xd8\x89Y`\xaa\xb9c\xd8=M\x801\xbc\xdd\xdb\xdb\xdb\xf3|\xbayus6\xf7n\xa3;\xc3h\xb9h&\xd8\x1bSN\xe0\xc2H\xe0F7\xf3Q\xd7U\xb6\xc6x\x11\xf9\xfe\xf1A\xd2\xe9\x15\x89\xcdp\xd1\x83j\x04\xad[\xc8\xfd\xe3\x83\xc1\x16\xac\x15N\xd5\xb9\x19Ar\x1cF\x1f\xcd\xf7T\x187\x15hf\xa4\xcd$$\xdc\x81\xd8\xe2\xd3\x8d\xd0\x8d\x02\xe3\xc3\xb5)I\xcf\x94\xa8t\xd4\xa6\x06\xe3\xae\x1d\x88\xd5$\x1c\xac\x1d\xcd\x8fJ\xaeZ\xd4\x92F\xfc&t\x9e\xc9\x18\x8f\xbd^\x18\xd5\xa8*\x9f\xd8;\x88\xe4)\x15\xce\x0f|\xab6\x82y\xda\x11\xd23\x1aXsw\x19\xddC\x7f\x9b1\x8b$\xed\x90v\x07\x8fgI\xa3\xbb1\xec\x10&}m\x10\xff\xb2^<\"\x1a\xd1\x0e\xb7/\x86\xe2\xad\x10\xac\xd8:N\x98\x99\xb6\x07\xe5\xef\xc0\xf2\xc0\x91t:\x0c\xcc\xdbn\xc2\x01\xb7\xabP\xea\xd9\xb3\xa9a\x17\xc9\x8e0.\xf0.\x85\xabd\x14q5\xfd6\xeb\n\x07\xb1\x1d\xa1\xc8\xa3\xf3\xae\xcf!6\xae\xfe\x11\xd8\xa6Ye\xcf'\x17\x8f\xca*\x892\x03\xc5\xa6\xa6{\x06\xc2\xd7\x1a`2Md\xee!&\x1a;\xcct\xe3T\xcb7\xd3p\xfe\xf1\x03\xbc}\xfb\xf6\xc7\xd0\x06ui\xdc\xd3q\xa5\xd1\x1ct\xf5\xe8\xdfS8\xf0\xd9.^wP\xb077\xef#\xd8\xbcp\x871\xc4\x16as-\xe9\xab*\xcf\x02\xe9\xfe\xa0\x81\xa4\xdb\xfe\xecxv\xf9\xfed\x92\xf6\xba\x04\xc9\xf4\xd1?\x13\xe7\xe3j\x04\xfe&=\x86\x86\xe77\xf5\x96\xa5\x8f\x87\xf5qq,\xeb\xeb\xd2\x9e\x8ek\xcc\xb0\x92\xf9\xf2\xc2\xaa\x0e\xfe\xe2Pd\x82		C\xf8+\xfe\xb8\x8b\x85\x02\x1b&\x9c\xaf\xab\x91?\xb7\xab4\x92o\xd5\x06R\x947\x0e\x8c}\xaaR>L</M\xeb@\xc0wKYR\xf3u\xca\xcc2[\x94R\xaa\xc4\xefO7\xab\xb8\xa7\x96\xc9\xc5\xa4\x11$\xb1\x082#O$5\x88\x03H\xc2~x\xe3\x90\xa7\xf0\xda\x8a\x17aBy\x7f\xec\xb5\\cf\x07~\xac|\xa9\x8b\x1c\x06\x1fi\xe7\xf8\x02i\x8ehH\x9e\x9d\xde\xd6\xbc\x1a\xf2\xd5\xee\x15P\xb7\xde\xde\xd8a\xaf\x97\xbb\xcca\xdaF\xba\xb5\xcf\x83\x7f\xbbO\x1c\xb6\xd1\xef\xd8\x92v\xd5\xffo\x8a\xca\x9d\x11y1?\x9f\x9e\xfd\xb4K\xf4\x97\xab\x97\xb4\xdb\x8dD\x7f\x84qQ\xd7>\xd1|\xab\x86\xb7&\xe1\xddw\xa9\xdc0<\xe9\x86`\xa4\x9a\x19\xe99\xf5\xf1\xd8\x9d\xe45/h-\xa4Z1\x93\x857\xb4>\xd1Tb\xe7\\\xcf6\xeap\xd1~\xf5%\xc2%}\xbc\xaf0\xa7\x0e0fgJ\xf3t%\x87J5\x17\x9a\x9e\x17\xf2\x0d:\x19Pa\xe4:\xa6~\x1a\x97\xfd\xfd]+h\x8f\x99aOzM\x9c\xa5\xfc\x1fds\"\xfc}\xfd&r\xfbt.\xf7\xc6{o\xb5@#g\x8f#Ic\x13\xe4A\xac\x93}\x8bkE.`\x0c\x0d\x1d\x1f\xdcI\xea\x13\xf9SN\xd5~\x83\x84\x1a\xbcz\xd52\x0e\xcdU\xb5\xaf\xc9\x80\xa2^\x85\x17E\xb8r\xb1\xa3A1\xb3D\xbage\xf6\x0e\x9e+\xa0\x91]\xb8\x00\xe0\xe2\x86\xae<k\xf7\xbeK\xd8c{xj?\x16\xac,\xafY\xfey@\xfd\x0d\x13\xeb\xed\x97:6Y\xda\x9f\x00\xd9\xa1^\xedO\x0e|sO\xf4b\xef\xbc\xd5\xee\xafpum\xe7\xbevD\xb7?\xd9\xd1\xe5[\xaef\x8b\x11$\x13\xdb\xce?\x85*p;\x82\xc9!\xf5\xf9]\xd7\x9d|_\x97%\xa9\xbe\xab\xb3N\x9eOo\xb3\xc5\xb7{\xe0\xc4K\x1ef*\x16\xd1V+\xe1\xfd|\xabE\xf0\x16\xca\x16\\i3S\xf4\xa2\x17<\x04\xa3$\x9c\xd2\xf2\xd8\xa3|\xa4\x01N0C\xc4C\x9f\xc3\xd1\x17\x92Yp\xc1W\x0fM1\xf6%\xf4\x8c\xad\xf0\xd1Sxe\xbf:9\xcdu\xcdSa6\x82\xc3\xbf\xca\xb6\x1d5rA\x1e\x8e7\xa8\\\xccd\xdbN\xbd\x85\xed_\xe1\xd7\xf1\xed\x9cv|\xfc\xdb\x9dzz6\xdf\x95\xf4^\x90~wx\xf4T\x98\x7f\x95;Oc\x19\xfe\x7f\xe3\xcb\xff;\x00PK\x07\x08\xcf\xf4\xac!\x98\x0d\x00\x00\x8b.\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x81UR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00python/__init__.py.gotmplUT\x05\x00\x01\xf3\xa2\xd4jD\x8a\xb1\xce\x82@\x10\x06\xfb{\x8a/G\xfb\xff\xd0\x1bc\xec\x88\xb5\x85\xf5\xb2\xacp\x01\xee.{\x8b	ooP\xa3\xdd\xccd*\x1ci\xb5\xf4?H\x14%\x93\x1e\xcd\xc9U8\xff\xbc\xdb0\x04\x1b\xd7\xae\xe6\xb44<\xd2\xa4\xc1\x1a\xcd\xec\xbc\xf7\xedw\xbbkZ`\xa3\xa0d\xe1\xc3\x8bl\xcbR\x10\"4\xf3\x1f\x08<\x07\x89\xb6\x87\x0fQ\xecA\xb8]\xdb\x0b\x8a\xe8C\xd4\x95If\xb1\x14\xf7\xe9\x9dj\xe7\xbdw\xcf\x01\x00PK\x07\x08\xd7\x8a\x05\xca\x7f\x00\x00\x00\xa7\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00dUR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00python/client.py.gotmplUT\x05\x00\x01\xbc\xa2\xd4j\xdcX[o\xdb\xc8\xf5\x7f\xd7\xa78`\xfe\xc0\x9f\xcc\xd2\xb4w7\x0f\x85\x10.\xea\xdaJc4\x91\x0dG\xde\x17C\xd0\x8e\xc8\x914-5\xc3\xcc\x0cc\x1b\x82\xbe{q\xe6\xc6\x8b$\x07i\x8b>\x94/\"g\xce\x9c\xcb\xef\\Go\xe0=i\xb48[SN%\xd1\xb4\x84\xf3\xdfFo\xe0\xcf\xed\xf7\xf2\x05\xd6Lo\x9aeV\x88\xedy\xb1!\xff\x90L\x9f\xcb\xba\x18EQtU1\xca5\xac\x84\x04\xbd\xa1 \xebB\x81X\x99wU\xd3\"\x85e\xc3*\x0d\x82C#\xab\x8a-a%\xc5\xd6nk\xc2K\"K\xa8\xd8R\x12\xf9\x92EQ4\x1a\x99\xed\xc5b\xd5\xe8F\xd2\xc5\x02\xd8\xb6\x16R\x03\xe1\\h\xa2\x99\xe0j4rk%\xd1T\xb3-\xf5\xdf\x7fW\x82\xfbw\xfdR3\xbe\xf6_VtF\xa5\x14r\xb0&\xe9\xd7\x86*\xed\x04g^\x1eZ7z\x03\xd7\x93\x0f\x97\x0f\x9ff\x8b\xd9\xcd\xe7\xc9\xed\xc3\x0c*\xb6eZAA\xaaJ\xa5\xc08(Z\x08^\xaa\x14\xb6\xa4\xa4\x88Ta\xf0PPHj\xc0|bz#\x1a\x0d\x04PU\xd1\xe8l4d\x9a\xc3\xaf\x17\xd9\xc5h\xf4\x91\x92\x92J\x05\xb9\xd3>\xfbLj\xfc}TZ\xa6\xa0\xb4\x9c\x8fF\xa3\xa2\"J\xc1L\x12\xaeP\xd1	\x9a\x14O\x9e\x0bZ#8\xc9x\x04\x00\x10EQ\x9f\x02\x98\x02I\x98\xa2%<m(7\xf8;\xcb\xa1\x10MU\x02\x17\x1a\x96\x14\x14:SH\xe0\x02$U\xb5\xe0\x8a\xc2\x13Q\x86\xab\xa4\x05e\xdfh\x99\x1a\xa3\x0c\x0f\x83\xa8u\xa9s/\xe3\xb8\x98y5F\xe6\xa5\xa4+X,\x18gz\xb1\x88\x15\xadV)l\xa9\xde\x88r\x8cf\xa5xb\x0c\x7f!\x8a\xb6\x86\xc0\xd9o0\x15\x9cZ\x83\xf0QMMe\x9cd\x81\x91e\x01?A4\x86\x08~BV1\x952I\xda#\xb4Ze\x8e,w\"\xfb\x9bTJ\xc8Q~\x80\xf6\x9a\x16\xa2\xa4'q\xedl\x0fA%\xa0\x9a\xa2\xa0J\xad\x9a\xaaE\xaf\x07oiN\x97)4\xaa!U\xf5b\x94Y\xd2\x824\x8a\x1a@m\xf8\x00\xe1%(*\xbfQ	OTRh\x93\xd1`]\xb2\xd5\x8aJ$\xc4\x1cS?\x88\xb6\xd2D7j\x0c\x8c\xeb\xff\x04\xf4\xc6&\xc6\xd7\xc1\x07\x96\x7f\x82\x8e	0\xfc\xeb\x1e\xb2\xdc wj\x7f\xcf}!\xee\x0f3\x01\x83\xbbt\xc9\x1b\nV\x8b\xac\xcb\xdc\x14\x9e6\xac\xd8\x80\xda\x10IAp\n5\x95 \x85\xd0\x8e\xc2\xa2=\xdbPX\x12E\x17\x8d\xac0\x10\x9e6\xe8't\xa1\xf3\x1bS\xb0\x15\x0d\xd7\xe8m\xd5\x14\x1b \n\xfe\xd8h]\xab\xf1\xf99}&\xdb\xba\xa2\xa6\xa0\x92\x9a\xfd\x91\x1a\x8f\x13\xc3\xd9\xd5	\xac\xa2\x98\x00P2E\x96\x15U\xc0tf\xc4n\\\x9d@\xfdL\xbe\x9at\xa4\xdf\xa8|1\xc6Yf\xa8\x8a\xa8\xd1\xba\xd4\xb0\xed$\xa9/z\x19\xd6\xe6r\xe1\xa8\xa0 \x1cHY\xc2\x86\xf0\xb2B\x01\x88Q-\xc53\xa3\nk\x02i\xf4\x86r\xcd\nS\x87_\x0b\xba\x9e\x93\xd2\xf0\xe5\xf1\xb2Y\x1f\x96\xdf\xb6\xaf\xce\xf6\xb1\xaf\x80\xb7&\"I\xf5\xb8\xaa\x04\xd1s\xc8\x87\x15\xb9=\xeaP9<\xea\xca*\x1eF<\xdb\x13\xd6\xee\xc3\x03\x03\x90n\x0d\xd95\x93\xb4\xd0B\xf6\xd9\x1c\xcb\x15\x0c\xda\x10\x19y0:\x93JKV\xc7\xd1y4\x08\x7f\xef\xf0\xdc\x9b\xdf\xdf\xf6\xee\xce\xa1d\x85\x8e\xfd\xa7\x90\xb0\xdb\x0f8Y\x8b w\xa6!\xcd+\x1e\x8f\x93\xb6:c\xdc\x9cr[\xb7T\x07\x12\"\xd7-\xd6\x9f\x98\xd2\x8f\x0e\xc6K\xfe2o\xc9Lq\xa0\x81\xf0\x8aT\x15\x06\xf3\xe3\xe3\x89\x93\xf3\x14\x8e\xf2\xd1\x1b)\x9e\xf8\xf8hgtk\xb3\xa6\xaeh\x87WzR\xa8Q\xd1\xef\xe2\xc7\xbc\xa3\xb1\xc3\xf70\x04\x8f\xc6\x91	\x80\x96S[2]\x8aA>\xf4\xc0\xbd\xfdm\xc1\x0e\x8e\xf6\x91\x82\x85\xf3\x1c\x8b\xa9\x05\xbeU\x0d\x9f\x92h\x92\xe3\xa4\x93\x95\xcd\xb6V1\xfa!\xc9(\xc7\xbe\x12G\x8d^\x9d\xfd)J\xfaG\x9cE\xf9\xee\xed\xdbn@\xa5\xf0\xf6\xed \x9aR\x88\xae\x04\xd7\x94\xeb\xb3\xd9KM\xb1\xaf\x92\xba\xae\\\xc6\x9f\xa3\xd8h\xdfgnu\xcc\xa3\xbb\xdb/\xb3\xa8\xddr\xa1\x85\x8f\x96\x1dX\xf01\xe5\xaa\x13\xaf&lc\x87O\xea\x93 \xef\xe6F\x82\xd53\xf4\x92\x1e7|lkHAR\xa2\x04Oa)\xca\x17\xc8\xc3\x01\xd7C\xd2v\xc1\x13v\x17\xca\xb8\xcd&j\x9a\xa1w\x9d\x99r\xb2\x8f\xb3\xd9\x9d\xed\xfbD\xd9\xb6	o\x9cd\xac\x8fzC\xb1\xa3\x10\x0e\xbf<?\x07FG\x01xEe\x9c\x9d\xd0\x95\xa61\x075\xdd{W\xc3\x8e\x96\xb7_\x82Zh\xc6\x02u;\x90g\xa6\xbf\xe1\xdc\xe8\x02,\x1cKl\x97\xf0\x9fC8:\x82\x0ed\xbc\xca\xbfem&-\x7fHR\xd5Tm\xb1\xbf\xe4/.\xb7\x02\x85-\x1f\xc6\xa2\x83\x96\xf0;\xa9\x1a;\xa7\xcd\x87\xc7\x0eB\xceJ\x82\xdc\xdc\x11\xb2J\x90R\xc5\x18$YI{\x89s\x10\x01\xad\x10\xef\xf5@\xd1\xd7\xcf\x8f!~\xc7\xc4\x8c\x8d\xc1\xa6\xd2\xd9\x9a\xea82kQ\x02l\x05L1\x8e\x17\xa0\x82\xc6\x96\"5\xd5=\x01Z)\xda7\x86\xad\xccp\xfe\xcb\xc5\x05\xbc\xf7S\x10\xbc\x87_/.L\x9f7Lq\xda\xe8\xb7\xa1\xd6'\xb2.\xb2\xfb\xbb+\x83T\xbc0\xfa\xae\x84\\\xd8\xc8\xf5\xc3\x9a\xcf\x1dl\x19\x9d\x19.5\\\xfd\xfc\xe8G\xc7\x0eJ\x15[u\x9c\x84j\xa0\xae\xa7T\xe9\xce\xd6}\x96i\x87\x8b\x8b\xc2v\xa1\xad%l\xd5\xda{\\P\x1f[C\x8c2db\xd3\x15\xc7%\xa2\xa0\xae\x08^\xde\xb4d|\xad\xf0\xda&\xaa\x92J7\xba\xb53\xe6\xd0\x99\xbb\x08u\x8c\xc6\x06\xd3\xab\xdb\xeb\xc9\xe2f:\x9b\xdcO/?\xa5\x10m\xa9Rd\x8d\xbbF\xea\xbe\xd5\x1a\x1f<\x888\x1a\x1a3\xcd\x9a\xcb\x8a\x9064p;J\x92t\xb8\xee\x99v\"\x13\x9f\x92j\xc2*\x9c	:\xb4n\xb13^\xe0\xc3VF6^\xccl\x135Q\xe3\x19\x9ctX0{a\xaeE\xc1?\xfe`\xee\xb8=\"\xf3yO\xe2\xc9\x9a\xe72\xa6\"\x92\x96^u\xcb>\xees\x8f\x9d\x94$ v:\x04\x07%;\xc6\xc6e\",\xed$o\n\x7f\xa3/\xe6\xcd\xdd\x90\x87OG-\x0c^\x8c\x15o\xa9\xde\x10\x0d\xa5\xe0\xff\xaf\x1d\x06f\xf0v\xb7i\xa2\x80p\xf0\xe9\x05\x98\xd4\x94\x94\xa3!{\x9b%V\xc2\xab\x90\xb7\xb9\xe25\xea\xf1:\x92\xd1\xbd\xc0J\xbd\xd6\x87x\xbdV\x1cu#\xb9\x1a\x14+\xb7\xfa\xc3\xe5\xaa\xe5\xe8\xe0\x8a=\x7f\xb6\x82\xf0\xdab`Y<\xce\x93\xd1\x0fz2\x85\x1b^\xd2g\xf3\x9e\x1c\xad\xce\xdf/:\x83\xc642\x7fT\x1c/\x91\xe6\xbel\xc6=\xa5]\xef\xb3\xd6\xc0.\x08}wq\xd1\xab\x0d\xbf_~\xba\xb9^\\\xde\xff\xf5\xe1\xf3d\xda\xb9\xab\xbc\xbb\xf8\xb9C\xf70\xbd|\x98}\x9cLg7W\x97\xb3\xc9u\x97\xec\xd7\x0e\xd9\xdd\xe4\xfe\xf3\xcd\x97/7\xb7\xd3\xc5\xf5dz\xd3'|\xd7!\x9c\xde\xce\x16\x1fn\x1f\xa6\x8e`o\xfc\xe9->\xa8\\\xc9h\xb7;\x03I\xf8\x9a\xc2\xffmE	\xe3\x1c\xb2\xcf\xa2l\xf0\xca\xb9\xdf\x87\x8b\xf5ng\xb63\xfb\x87\xdf\x94l)\xec\xf7\x16\x87\xdd\x0eJQ\xd8j\n\x11\xca\x8c\xba\xb4\xd7\xa2@F?~Kt\x1d\xff\x813\xc1\xed\xa8\x1f\xe6\x97\xf9\xff\xea\xf5\xb1\xdf\xbf\xfc\x95\xa0c\xf9\xa0\x80\x99)y\xa1=.\x9d\x0bg \xc3\x04\xfb\xde\xa1\xc0\xbe#\xd2\x0f\xe2\xee7\x0d\x17	\xf7\x9b:\x08r\xfb\xd3&p'\xa0\x8a\x0d\xabLH\xd9x\xc0/I\xb9\x0f\x87`\x00\x06\x97!\xcd.\xb5\x96l\xd9h\x8c.\xc8\xa1\xdd\xe8\xc5]<\xb0\xa0/\x9b\xf2\xd2K\xe8\xa8\"\xeb\"(r\x7fw\xf5\xa1\xe1\x85B\xb2\x10\x98(\x0b\xd3\xc3\xc5\xf6\xa9\xebp\x87%\x91k\xc3\x12O]\xca\xb5\xf2R]R\xe0\xbe\xe76\x0e\x0b\xd8\xa0\xfcjzJ\xef\xc1\xff\"\x0e\xf1\x1f\xb9\x94\xeev\xa0\xe9\xb6\xae\x88\xa6\xe0\x8a9J\x8e\x8c\xb6!u\x8f\xa7\xafKa4\x0b\x93\xd7\xbc\\\xb6\xff\xc0\xc3YGKW\x04\x07\x0e\xc9\xfa\x7f(81_\x1b\xa1\xa9ew\x7fwuG\xf4\xa6\x87\x01>\x8f\xbb\x9d/F\xac|N\x8fb\xbc\xdba\x9a\xe0>\x1eG\xc6\xd6\xe3=\x84'\xe6J\x0c\xfb}\xec\x97\x1d\xe6I\xa0\xef\x14\x11|*\xb2]\x96\x04\x1cw\xb4\xf9\xde\xb5\xab\xfd\xde5.<\x8a\x03\xfa~\xbf\x08\\\xc6}\xa8m\xd3s'\x03\xda}I\x1d\x11\xa6/a\xe0\xec\xba\x81eMw\xf3\xda8\x1f\x92\x9e\xb6\xdf!lOfW\x16\x811\x18\x0c\xfc\"\xces\x1e8\xbfh\x9bd\x07\x9d\xb3\xfd\x1e\xf6\xad\xb9\xbb}\x90\xd27\xc5\xd7\x82\xb0\x98\x8c\x06\xe1\xdcy5\xef%]1>\x88I\x1bP\xb8\xebn;\x01z\x1fj\xeeJ\x84$F%\xbc	|\x85\xb8\xa2<\xd0&\xf0s \xdf\xed f8\x1e\xb4\x9c.\x12\x1f\x01]>\xfe\x80K,\xfb'\xd20\x06%\xd5\xe8\x85\xc0\xeaU\x17 \xb5\x97\x14\xd6\xe7^\xa6\x856\x80r6De\x10>\xff%`\x82\xfb\xfd\xb0\xf6x1O\xba\\\xbd\xb4\xf8\xdf\x87\xe6P\x16\x06\xa7Er\xde&g\xf2\nb\xff\x1c\x00PK\x07\x08\x17g\x13O\x0f	\x00\x00\xb4\x1c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xbbUR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00python/rpc.py.gotmplUT\x05\x00\x01b\xa3\xd4j\xb4Y}o\xdb8\xd2\xff_\x9fb\xa0\xec\x02\xd2VQ\xbbO\x8b\xee\xb3\xc6y\xb7A\x92^s\xe9&\xbd\xd6\xdd\x03\xd65\xb4\xb44\xb6\xd9H\x94\x8f\xa4\x9a\xba>\x7f\xf7\xc3P\xa4^\x1c\xc7N\xeepF\xd1P\xc3\xe1p^~3\xa4FG\xf0\x17V\xe9\xf2x\x8e\x02%\xd3\x98\xc1\xd3_\xbc#x\xd5>OW0\xe7zQM\xe3\xb4,\x9e\xa6\x0bv#\xb9~*\x97\xa9\xe7\xfb\xfeh\xb5D\x05\xe5\x0c\xf4\x02A-1\x8d\x80\xe5\xa5\x98\xc3-\xd7\x0bCD)K\xa9@-\x98\xac\x85\x111\xcd9\n\x0dLd\xe6Q\xa1\xfc\x822\xf6}\xdf\xf3f\xb2, If\x95\xae$&	\xf0bYJb\x15\xa5f\x9a\x97By\x9e\xa5M\x99\xc2\x97/\xdcS\xc64Ks\xa6\x14\xaa\x0e	5/\xd0=\xa3\xa8\n7\x96\x0dU\xaf\x96\\\xcc=o\x04C;\x8e\xc9\xac\xdf\x99\x0c\xfc\x91\x1fz\x97;\xe8\x97~\xe8yG\xf0\x0f\xcc\xf3\xe3\x1bQ\xde\x8a\xdaNH\xcb\x0c\x15\xccJ	3\xc6\xf3J\xa2\x02\xbd`\x1a\x98D\x10\xa5\x86\x0c\xd3\xdc8\x82\x8b\xc6e\xb1wz}v\x9e\\\\\xfd~\xf2\xf6\xe2,9y\xff\xd7\x8f\xbf\x9d_\x916>\x17_X\xce\xb3\x84\xc9yU\xa0\xd0~\xcd\xfa\xf1\xea\xe4\xe3\xe8\xcd\xf9\xd5\xe8\xe2\xf4dt~F\x9c\x95`\x95^\xa0\xd0<\xa5(Z\xc6w\xe7\xef\x7f\xbb\xf8\xf0\xe1\xe2\xfa*9;\xbf\xba\xa8Y\x97(\x0b\xae\x14/E\x92\xa1\xe0\x0d\xf3\xd5\xf5(y}\xfd\xf1\xca0\x89R'\xb3\xb2\x12n\xf2\xe2jt\xfe\xfe\xea\xe4-\xcdq\xa1Q\n\x96\xfb\x9e\xe7\x19\x8f\xc3\xfbw\xa7\xe7\x14\xe7\xe0\xfck\x8aK\x8aR8\xf0\x00\x00|\xdfws\xc0\x150\xe7'\x89\x14\x84\x16\x105\x02\xa2\x166r\x996\xf0x3\x1a\xbd\x03\xa5\x99\xae\x1c\xd4\x8cl\x89jY\n\x85p\xbb@\x01\x92q\xb5\x0d\xb0\x18\xde0\x91\xe5(U=\x0d\\\xd7;\x94\x02\xad$\xb8mCh\x82gD?6\x80\xd6T\xcf\x0c2\x9cA\x92p\xc1u\x92\x04\x86B?\x85\xf9,j\x9eh\xab\x01(-[R\x81J\xb1\xf965C\xcdx\xae\x06\x0e\x81'b\x05C\xb8*\x05vW\xeaE\x99\x99\x85\x14\x1e\xbf\x9d\xa9\x9d6\x00.4\x0c\xe1Y=\x11\xc2\xf1/F\xc2\xa0\xe5\xab\x96(\x830n\xd4\xb6\xca\x84-\x07\xe6\xb3\x98\xb4\x86\xa1Q\xbe?a\xd9a\xe8\xac\xe8O[#`\xe8\xcc\xd9^M\xfa\x9b\xc54\xe8O\xda\xb8\x0f\xad-]\x17+-\x93$ .c\x92\xd2\xb2\xb5H\xa2\xae\xa4\xe8\xc9\x7f\x02\xfe\x00|x\xe2\x88FQ\xe0\xb3\x1e\x13\xe6\n\x1d\xc104\x18\x1f-dy+\x0c\x94\x03\x87\xe9\x16\xe5\x9dY\x02:a\x90\xca\x13\xd4k-\xd6l-\xdc\x85\xa1\xc8\x01\xd8e\x00\x97\xd6R\xe3\xb0\x18>\x8cNF\x1f?8\xd9\xd6+s\xfe\x05E\xbd\xe4\xcfW5\xed\xcf\x88Lbb\xb5\x85J\xcab\x83\x0eC\xae\xa55\xa8\xba69\xcb\xf21\x17zb\xf1\xe5y\x1e\xb99C\nw\xf2\x85\xe5\x15\x06\xe5\xf4s\x17\x89\xc6\xed\xed\xe3\xc0\xa6\xa5\xf1|9\xfdl%\xa0\xe8H0r\x1e*\xc30\xf7\xf5PZ\xee\xd4\xa2	>\x9f\x99<\xe5\x8a\x0b\xa5\x99H\x8d\xd2\x11\xcd\xdb`\xd1\xcf\xf8\x1a\xa8\xca\x9b\x88\x05>~]bJ\xf5Hi\xc9\xc5<\x82y\xa9\xe1{\xe9\xc3\xf7`\xd6\x87\xe1n\xdb\xacw\xa6e\x99\xefT\x8b&\xf6\xeaE\x0c\x0fR\x8c\x18\x91\x89\xc7j\xc6\x85\xde\xa9\x18\x17\xba\xd1k\xdbW\xb4U\x08\xa5\xdc\xa90\x17\xfaA\xfa\xd2!1G\xf9X}gy\xc9vklf\xfe3\x9d\x03.tT\x0b\x08\x1f\xa4\xbc\xa8\x8a\xe9A\xdd\x1bU\xc3>\xd0\xe9\x96\xe1pN\xe3\xf8\\T\xc5\xbeT1\xbc\xb1\x03\xfb\x11T\x82\xeb\x9a\xa8\xcc\xc5A\x99\xbb\x92\x02,\x96zE.\xc3T\xab.\xfch\xc1N\x9f\xb5U\xde\xeaL\x84\xbe\xb6f\xad\xd5\x96f\xf7)\xba\xde\x18\xfd\xe8J\xa5\x80\x0b\xc53\xa4(m\xa9Y	\xfe\x15\x14\xa6\xa5\xc8T\x04\xf8\x05\xe5\xeav\x81\x12\xeb\xda\xca\x14\xbc\x7f}\n\xcf\x9f?\xff\xd9&\x9b\x8a\xe8\xa4o\xe4R5++\x0d\xcc\xec\xf3\x8d\x8ej\x92\xae\xd9\x0d\n\xd0%L\x91.O\x1fG\xa7^r\xfe\xee\xfa\xf4\x0d\x9d*\xf6\x9e\x17\xbbA\xf0\xe3\xcf?=\x8b\xe0G\xf3O\x7f\xe3bV\x0e\x1b&'6\xaet\x1az\xc9\xfb\xd7\xa7F\x99!H\xa4+\xee\x92\xe7X\x1f\xdb\xd2\x0f>e\xeb\x17\x9b\xf0\x98\xfe\xfe_\xfbw<\xd20\xb1\xb4\xc1\xd6\xdf\xe0\xd7\xc1\xa78\xf8\x94=	\xc3_\x83\xf1\x1f\xdf&\xff\x1a?9\x9e\x98\xb9\x81\xf9?\xfc\xce\xf7\x1cb\x12v\xcbdS\x14\x1b\x0d\xdd\xc0\xc4\xe2\x0e\xb5\xc9\x01\x13\xb5\xb86\x8f\x0e\x86\xfe\x99n#^3I\\\xe6,\xc5`\xbf/\xb61\xe9y[(\xfb\x9a\x90\xf3vB\xed\x1e5\xad\x166TOZ6\x12\x94a\xaeY`\xa12\xb4\xdb\xb4i\xb5\x95W\xed\xfe{\xfd\xd5\xa9\x13v\xf3\xa0\xeb\xe6\x10\x8e\xad6a\xacK\xcd\xf2\xc4\xee\x1f\xb8\xed\xac\x1e\x8f\xb5\xb4`:]\xc0\x10\x1c\xa0bC\x08\xfa\xe7\x96\xad~|f\xd9\xefF\xcd\xd4\xa4\xdf\xc9\xc0\xed\xa3\xa9I\x1b\xd2lWm26\xaf\x90\xc9\x08\x8aR\xe8E\x04\x19[E\xb0(+\xa2pQi\x8clb\xc2\xd0\x14\xc5`\xc9\xa4\x0e\xcdu\x97F\x94XF\xafx.\xcbj\xa9\x82p<x9\xa9U.x*K\xba\xc2\xd1\x89\x12t\xb8\x82\x9f\xccY\xe1\xfb\x869\xce?WJ\x07/#\xf0\x9f\xf9\xd6Z\x93\xc3\xc3\xae\xe4\xe0\xff\x1b?\x98I. \xf0\xff\xf0#\xf0\xbf\xf9\x9d\x02\xad\xbfu\x93\xbb\x9b\xb7f5\xd5\x93\x96\xb9\x9c\xcd\x14\xea\xed\x055\xc4\xc8\x05jH\x9a\xd3v\xe3\x1f\x07\xcf'\xa1sI\x87\xfe\x82\xac\x0d\x0fl\x1f\x1c\xdb\x9d\xac\xf2\xe3g\x13\x18\x0e\xc1?\xf6\x8dBV\x8f\xdeI\xd1\xc8p\x83\xe0aQ\x8a\xac\xd7\x9b\x1a\xa6\xbfm\xe5\xc4\xe1th.F.\x13\xbb\xb9\x10sU\xceJY0m\xd0\x7fD\x9a2\x02\xa5;r\xe8\x0e\xfb\xf2\x85)\xd0\xf6\xf81\x1c\x05[Q\x1dv\\\xa2\xca\xf3\xeeyD<;\x8b\xc4t\xa5Q\xf5rs\xea\xfb\xe4\xc8r\xfa\xd9\xe5B\xed\xc5z\xe3x\xfa\xf2E\x9d@w\xf3\xa8\xe7\x07\xb3\xa3\xf5\x83\xd9d\xa7\xed\xad\xd0z\x99\xf3\x82\xdd\xc2g*\xe5\x9c\xde\xec\xbd#km\xce\x95\xa6\xd7\xd6\x0c\n\xb6T\x87\xec&\xee\xc0^\xdb\x89\xd0\x94\x8eS\x96\xe7l\x9a\xe3x\xdc\x9e\xac\x93\x08F\x13\xaf{\xdc\xde\xc3eg\xdfr\xa5\xc7\xa3\xc9d`7hv\xe5\x1a\x0b\xb5\xd3\xdd\xfd\x95m\xaa\xf4\x1d\xde\xd2;q\x19O\x1a*\xe6\xf7\xdc\xa9\xc9\xdcN\xb6\xee\xbfX3)\xd9jW\xd5r\x8bm\x8c\xc66\x18dU]\x99hD\x15\xa2\x9c~\x9ex]\xect\xed\xef\xc3\xa1\x0d\x04\x8a{\x021j={\"V\x07\x02\xe1|\xdf[\xd2\x83V\xce\x8ai\xc6\xc0Bp\x8c\xe2\x1e#\x0c\xc3\xc4jk\x0d(\xd8\xb2\x8b\x9a\xe4\x06W\x87\x90s9\x89:+\x0e\xe3,\xdao_\x0fhg<\xd5\xe3KZu\x17k(\xb4\xe4\xb8\x17m\xed\xf2G\x01n\xbd9\x0c\xb8\x8c\xa7\x0f\x07\\}O\xbe\xef\x0e\xdf	\xdd\xda\xdav\x83\xab\xe0\x06W\xe1\x00\xee@\xf0\x06WQ\x17\x871\x8dU\x10nv\xe1\xd1\xfa\xa8\x8f\xc8&\xc6(\xf6\xc4\xf8\xb2\x8f\xb0\xe8Q\x10>\x10\xe3NX\x1f\n\xe35\x8a-\xcf\xa0\xd8\xe7\x19\xb3\xac\xe3\x1b\xef\xc8\xbd\x06\x92\x07\xfbo	\xcd\xdd?\xe77X\xbf%@\xa9\x175k7;\xb8\xd0&4\x8f\xc0\xfb\xe5\x83\xea\xea\xa5\x05h\xdft\x93|\x0e\x00Bw\xcf\x1drAs)\xb5\xf4\xd2vN\x1e\xa3\xde\xa3\xca~\xd3\x9a\x19Mv\xeak\xea>\xdd!w\x1e\xa3\xd6\x90\xbb/\xa9}\xbd\x1f\n\xb1\xfdzwU}(\xc4\x9c\xe6\xe6\xb1\x7f\x05\xb0X33\xa1\xe7\xad\xd7\x10\x9f\x99\xf6y\xfd\x19\x006\x1b\"\x1eSy\xe2\x02\xc1\xcf:\x93>\xcd\xae\xd7\xc7 \x99\x98#|Go\xa90\x18BL=\x1f\xb3t\xbd\x06\x8d\xc52g\x9a\x96\xbao\x07\xbee\xb5\xabQd\xcd6V\x12Ji\x04\x99\xdb\xf9~I\xc4\xba\xd94\x0d\xc4\xf5\xda\x90\xe2+V l6F@\xd0\xe9\x1b\xda\xcaF-sz	\xc8L\xe5a\xdb\xcb\xea&\xa2\xed\x94s\xad\\K5\xde\xee\xf4\xad\xd7\xf0\xcf\xaa\xd4X\xaf>\xa5\xd6\xed\xa6\xae\xb1d\x19\x9f\xd5\xf4\x0f\xa6i\xe8fl\x93q\xd8l\xda\x9f\xee\xba\xa4m\xc3\xee\xeft[\xf5\x06\x8d\xc8\xbfW,\xe73\x8e\x99\xf5C\xcbj{\xc7\xae\x91\xbd\xd3\x80\xffi\xd7\xdb\xf4}\xc9}\x91\xd3%r\xfaG\xb69\x1dY\xe9[\x9d\xf1\x83Vvz\xdf\xf7\x01KTE\x8d,Q\x15\xea\x0ep\xa8\x91de\x05\xebu\x1d?\xa2]\xd8\x02\xbb\xd9\xb8GZ\xbf^\xd7\xf9\xbf\xd9\xd0\xd7\x84\xb6\x0dEt\x83i\x0b\xb6\xf5\x1a\xb22\xadk1\xf8D\xf1\xed^gejG'\xed\xa778\xb6@\xe9$D\x81\xd4+#\xcdk\xf6\xdf\xccs\x073\x90\x96\x05}\xbcj\xe4\xd7+\xcc\x0e\x9b\xcdz\xdd\x10ZWu\x88o\xb9F\xc9\xf2V\\\xe3<\x07ej\x18\xd9\xbd_\xb3<\x9f\xb2\xf4\xa6A\xe8+\xe3\xc1\xcew\x05:X\x12\xf3\xf9K\xcc\x93 \xa5\xc0\xda;[}_00\xa1\xed\x8d-\xdbQl\xef\x1e\xf6\xd0Hs\x15Sa\xa2\x08\x03u\xee\xea\x8f\x81F\xa4\x8a@U\xe9\x02\x18}\x03,\xa9\xf5\x95e\x98Q\x0bK\xe0-J\xd3\xf6W\xdbF\xf5\x86ftL>z\xfa\x03d\xdd\xda\xf7\xc3\xd3;\xd5\xaf-a4\xf5\xaayF\x157\xe3\x16P\xce\xd9\xf7\xc3\xc0\xc4\xe7P\xf0g\x1c\xf3\x8cb\x1f\xbf\xa6\xd1\xbe\xa8\x1bV#\xd4\x0e\xbb\xa2\xc9FGw\x9aAK\xa2\xaa\xed\xe8V\xe1\xc6M\xf7\xc7\x99>%'\x9fU)\xea8\xd7YC/2\xad\xb2I\x93\x10\xbbn\xb4\x14\xd9\x87@\xa0\xfd\xca\xe7t;\xe0\x1e\xf7\xbbc\xf4p\xcb\xe63s~\xc3fCG\xf8\xb8\xad\x88\xb5\x07\xff\xf6\xe1\xfa\xcaze\x12Fw\x94\xe8\xe4	\xfd\xc2\xf6\xdb\x99.k\xbf4\xdf\xceZ\xc3\xef@|\xfd_\x18w\x9f\xb6wb{.\x9c\x9d\xa4R\xdc\xce\xda\x05\x87\xadkS\xa7I\x18\x87z\xf8\xe1\xe9f\xe3\xfd{\x00PK\x07\x08<\xb7\xb83\"\n\x00\x00\xea \x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x94UR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00python/server.py.gotmplUT\x05\x00\x01\x18\xa3\xd4j\xa49ks\xdb6\xb6\xdf\xf9+N\x99\xeb)\xd9K3\xcam:sG\xad:\xf5\xdaJ\xe2\x99D\xf6\xdaJwv\xb4\x1a\x16&\x8f$l(\x90\x05\xc0\xd8^\x8f\xfe\xfb\xce\x01\x01\xbe$%\xce.\xbf\x88\"\x0e\xce\xfb	\xbc\x80_X\xa5\x8b\xd35\n\x94Lc\x06/\x7f\xf5^\xc0o\xed\xff\xbbGXs\xbd\xa9\xee\xe2\xb4\xd8\xbeL7\xec\x93\xe4\xfa\xa5,S\xcf\xf7\xfd\xbf\xdd\xbe\xbd\x04\x85\xf23JP\x9f0G]\x08X\x15\x12\xf4\x06A\x96\xa9\x82be\xdeU\x89id \xb9X\x9bU\xc5\xb6\x08\xb2\xa84*`\xca|j\x88zo\x8b\x06k\x01\xc8\xf5\x06%\xa4L\x80\xd2Ld\xc0[\x1a\x05-\xc5\xc4\x8a\xe7\xadd\xb1\x85$YU\xba\x92\x98$\xc0\xb7e!50!\n\xcd4/\x84\xf2<\xfb-c\x1a5\xdf\xa2\xfb\xbf\xd1\xbat\xef\xffT\x85p\xefZ\xb2\x14\xefX\xfa\xa9\xf9\xf0Xr\xb1\xb6\xb4bG\x82\xb4\xe1]`Zd(ab\x81\xe2s\x96\xe7\xec.\xc7\xc5\xc2~8\x13\x8f\xcb\xc8\xad\xd2\x1fo*\xbe}\xcf\xadfR\xdf\xa0*\x0b\xa1\xf0\xc0\xce8\x8e\xfbD\x9e\x9eNA2\xb1F\xf8\x9fm\x91\xc1x\x02\xf1\x87\"\xabrT\xb0\xdby\x9e\x97\xe6L)xz2\xcb\xf1;&\xb2\x1c\xe5\x8c\xcc\xb3\xdb\x8d=\x00\xa0\xb5\xacH\x95\x96d<\x9f\xbe\xf8=\xe0\x8b\"%T\xb4\xd0!\x96nxn\xc8\x19\xd0s\xfa'Q\xb4\x80\x16\">\xd3Z\xf2\xbbJ\x1bz\x8e\xf3\xab\x92,\xc6\xf2E\x0b\xd7\xe7l	\x13\x98\x15\x02\x1b\xaa(\xb2\x03L\xc82mX\xb8\xb9>\x7fS\x89\xb4\x16\x9b\xb6e\xb8\"\xd9\x08(\xb6\x02\x07f\x81\x1e\x85\xf9*j\xfeuP2\xb96(i\xd7\x99\\+G\xd5j\x8a\xd6\x1d\xb6q\xf3a\xfeX\xa2\xfb\xda\xc7\xda\xe1;\x84\xd3_i\x87\xc6m\x993\x8d\xe0K\xd4\x95\x14\xb4\xd97\x04\x1b\x93\x1c6\x8b5\x0dqF61/g\xad\xff\xc3i\x87U\xc9\xb8B\x98\x15\xfar[\xe6\xb8E\xa11\x9bJY\xc8\xe0\xe9	\xfe\xac\n\x8d\x10\x94\x92\x0b\xbd\x02\xffD\x01W \n\x0d\xbc\x05\xae9\"\xad^3\xbd	a\xb7\x0b\x87\xc6\xe8\xbcz\xde\x0b\x13\xe4L\xae+\"\x06Y\x1d/*2\x9f\xf3\xa2\xf8T\x95.]ljS\xc3\x16\xf5\xa6\xc8\x80\x82\x9e\x80jm\xc0g\x96W\x08X\xc7\x8e\xf2^\xd0.\xfc\x8c\xf2\x91\x021\xa2\x8cU2\xbd\xf1\x92\x9b\xab\x8f\xf3\xe9m\xe3R\x17<\xd5\x0b\xc3\xa1\xd2\xb2\xb6\x81]\x99We\x8e\x8bF3\xf6\xeb{\xae\xf4\xc2F\xf52\x1a\xae\xb6\xd1m\xfdr\x19\xed-\x0dCq\x1f\x8b\xa1a\xb3\x80]]F\x1e\xf9\xf6\xd3W\xe2\xf6\x19>n}\xa4\xb6e\xd7V\xe4E\xd0z:\xc5\x98%\xc4\xb3\x87\xe8\xa0\x87?=\x01_\x99u\xd8\xed\"B[\x9b\xb8\xe7\xdf\xb5\xb2\xea\xaf\xf5rG\xe2\x9cm\xef2\xe6L;\x1e&\x9c\xe9C)a\xb7\x8b\x07\xf1\x18\x1d\xe7R\xa2n\xb8\xbc1\x9e\xf15F%\xeax*\x8e\xf0\x18F]w\xed\xbc\x92\xefR\xa6\xc8p\xc5\xaa\\'J3]\xa9dU\xc8\x00\xa5\x1c\xc3_\x98\xc2\xe9C\x8a&c\x99\x10\xe6B\xd7Q\xea\xfb\xfe\xfe.\xd8\xb2R\x01R\xa8)\xd0\x05\xbc\x9b\xcf\xaf\xa9\xb2\xe9J\xa1\x8a\xc1\xc4\xa0\xa2\xf0\xc8\x99\xc4\x0c\xee\xb9\xde\xc0\x1f\xbf\xd5\x00\x7f\x00\x17\x06\xb3+\xaaP)\x04\xbda\xdab\x88\xe0\x1e\xf3\xfc\xf4\x93(\xee\x05P\x80(\"Gd\xf4\x06\xb9\x84JU,o\xa8Eu\x01m\x88\x19\xd4-g\xafG#\x13{&\xb8\xf4\x86\xb2?\xe6D\xaf\x80\x9fF\xa3\xd8\x89h~\xf9\n\xb8\xe2\x82*t\x8a\xa4\x98\x88\xa21\x9eodq/\x8cLa\x8dK\xca\xf8v~6\xffx;nLk\xe3\xba]2+\x98\xf3U\x9ds\x0e\xe0\xbd\xb9>\xaf\x91\xeea\xf9i4\xf2\xbc\x0e\xd6\xa7\x16\xa0L\xe3\xf3\xab\x8bir9\xfb\xfd\xec\xfd\xe5Erv\xf3\xf6\xe3\x87\xe9l>&I\xa3}\xb8\x8f\xb3\xb3\x8f\xf3w\xd3\xd9\xfc\xf2\xfcl>\xbd \xb0W\x07\xc0\xae\xa77\x1f.oo/\xaff\xc9\xc5tvY\x03\xfex\x00pv5O\xde\\}\x9c\x19\x80\xd7\x07\x00.g\xf3\xe9\xcd\xec\xec\xfd\x98\xa4\xa8\xd7w\xf1\x1a5\xc9\x1d\x935#\xe24l\x8a\xf6\xadi\xbd\x1a_\xab\xffR\xaaf`Z3V\x969OM\xf3c\x9d\x84 \xd4^{f\x83\x12\x98n{3\xca\xa1\xca`\x1e\xb6g\xd0\xb4g\x11H\xcc\x99\xe6\x9f\x8dO\xdcoP\"p2\x18l\x8b\x8ajD\\\x9b\xe2=\xffD^\x8a\xdd\x9d\\C!\xf2G`)\x85\x8e\x82\xeb\xab\xdb9H\xfc\xb3B\xa5U\xed\xf5Lt%xI\x9d\x19\xdc\x15\xd9#T\"GU3\x97\xa3\xe0(td\x9c+\xe7[\xae\x15\xc1p\x92\xb2\x80-{HhGr\xf7H\x8d\xe6\xfd\x06\x05\x94\x85\xe2\xc4r\x13j\xa6\x12fT7\xac\x1e\xac\xd8\x12AQ\xa52\xbc\x90\x06\xb6\xa8\x14[#\x98NsU\xc8-\xd3\x89\xf1\xf4\xfb\x0dO7.A\x18\xc2Tb\x9a\xbaU\x87\x9b\xd9f\xeb\x8f\xcb \x87\xb6\xee\xe7\x99\x86\xd3:Z\xf5\x86\x89^\x10\x00\x93u\x1f\x94\x17\xeb5fD\xff^\xady\\Gr\x1b\xa7M\xd3\x93$\\p\x9d$\xc7\xba\x1d\xab\x871\xd8\xda\xd6\xae\xfc\xd0\xbeZ\xcd\x8f\xe1\xae(r\x98\xc0\x1b\x96+l\x97\xfb\xaa\x1fSR\x84	t\xc2\xacU\xe0~\xd77\xac\xa4\x8b^\x92]F\xa4\xdf\xa5k\x01[\x94\xad\xca\xfe\x03\x94\\\xe8\x01J\x93\xcc\xa9\xc9l\x93\x0cu\x85\xb1U\x0fL\x9c\xa2\xfa\xcbV10q*\xea/\x0f|r2\xd0T\x1f\xb8U\x12L:\x1a\x83B\x92\n\xfa\xb0\xad\xf40\xe9\xa8\x82`\xf7}\xaa\xeb\x0c)\xcb\xf3\xe3\xce\x80\xe23\x97\x85h4j\xfa(\xe3\xdf\xf6\x03\xb56=\x1bH\x9dH;\xa0\x8c\xa17\xaf\xd8RKz\xb5\x9b/5J\xd3,\x19m,;\xe9\x9cfC\x98\x80m\xe3\xea4X\xb3b\xde\xfd\xeb\xb3\xf9\xbb\xe4r\xf6\xe6\xca\x8f\xc0\xf7\xc38\xa7\x01\xa5\x0c\xfc\x97~\x186X\xf8\xca\"\xe2j`\xc9}^\x03\xff\xf5\xe85u\xc4\xf0\xa6\xa8D\xe6G\xb0\x08\xfc\xf3Bh\x14\xfa\xd4\xf4\xe0\x11\xf8\x1a\x1f\xf4\xcb2g\\\xfc\x0c\xe9\x86I\x85zR\xe9\xd5\xe9\xff\xfb\xe1\xb2%\xdb\xa9?\x8b;\x83\xb6\xa4\xc4A\xd5lE\xb8\xff!\xfc\xa5\xd7@\xb7\xadp\xdd\x06GMs\x0b\x93\x9a\xfd\x06t\x83\xcc~?\xc0\xdb0Y\xfa\xe1\xb2\xd9\xa8\xe5c_vF\x8d\xdd\xa4v\xe8\xa4\xe6 \xa1oN\xc9\x91\xa3\x155\xfc\x1d\x92\x8fX\xa9\xb9\x0e\xba\xb1\x11\x06?\x10\xb2\xfe\x0e[\xcf\x9dp}~\xe8\xa9W\xb2\xc6\xd5L{l\xdf\xc9\xcb`\x02\x8bV\xa4\xa6I\xc8Q\x04\x0ei\x08\x93	\xbc:\x8a\x9a08\xd0\xc5h\x19X!:\x8a\xa2\x87\xba\x9cg\xa0\x08\xcc$\x12R\\\xda\xc5\xc8\x0e'\\\xc0\xbfx\xd90\x15\xc1>\x1d4-#$gv \xb2\x99\xdct\x86}\xda\xb6=2\xeaM$\x8a\x0ce\xd0\xf7\xdc\x886\xd9\xf8\xef\xd8\x8d>\x9a\x1a\x10\x0e\xa96\xfd\xaa#\x08\xf0\xc2%3\x05\x12\xcd\xb1\xc6\x8a\xf1\xbc\x92\xa8\xa82JF]\xd8\xfa\x909\x9f\xd9\x9e\xb9\xa79S\x89\xcdt\x99\xe0C\x1a\xacx\x8e\x13\xebw\x0b\xbfS\xbb\xfce\xf8\xcd\xaa0zj\x13]\x80R\x86}\xa5\x84\xde\xb7\xa8\xf6\xffF\xa3\xcevJ\".>\xb3\xb0\x93E\xbb!t\xe4\x10\xc1J\xf8\xdcLji\x1e\x8c\x86zd5Y\xd8\x94\xc26\xff\xba`\x1d\x7fa\x8e\xed&\xe0a\x8c\x8d\xbdA\xb8vkZ\xdf\x9cv<\x9f8\xc1\xea\xbc|3\xfd\xeb\xc7\xe9\xed<\xf90\x9d\xbf\xbb\xba\xa0\xbc\xf4v:\xf7\xfbv\xe4+\xb7\xf9\xbb	\xf8\xd4\xfa\xf9}\xd4\x9d\\\x17\xb3\xb2D\x91\x05\x81\x7f\x96\xe7\xc5=!4\x1b:Y\xde=\xe4\xa58\x08\xa9\xe0\xf5\xe8\xa7\x08|K\xae=\xc9`\x84\x0c\xb3\xc8LP\x06!\x9cX\xa6\xacY\xdd\x93\xd6% \xd1\x8f%\x0e\x85=\xbf\x9a\xcd\xa7\xb3y2\xff\xfb\xf5\xd4\xd6!U\xe6\\\x07\xfe\xcf~\xb8\x18-\xe3\xba(\x851\x11\x93A\x9fe\xbe\xea\xe3\xfenr \x87\x8f\x9f'\xe5\x1e\x14=\xaf_\x91\xe8\x96\x06\xf9\x03v\x14\xa0\xaa\x92\xc2\xdc\xa9`\x8f0\x9c\x98\xb3\xd08\xab\xb6\xa5\n\xba\x8c\xee+>\xf4\x8e\x17\x9a\x1c\xc5Zo`B\x0d\x96+.}\xe5\xbd\x9f\xce\xde\xce\xdf\xf9!u*\xa3p\x98!\x7f\xa7$k\xb2\xe3\x11\xbc\xa3\xe63_\xc1\x08~9\xd8f\xfdb\xf9\x18{_U\xe5\xebW?Ft\xf4fF\x11\x1a&\x1eIe9\x93k\xd7|\x9f\xd0\xb4\xa0Q\x91\x8e\x0e\x10\xebh\xe3\x05\x0d1\xb8-\xf5c\x83\xa8\x99\xb0\x98j\x17\x9bc\xb1\x9c\xab\xb6_4[&\xd0\xcf\x8c\\\x94\x95\xf6\x97\xb1D\x96\x05\xb5P!In\xf5\xf1+\x8cL\xfd\x82;\xdf?n\x14\xc9\xee\xa9r\x1b\x03\xe7\x05\xcbT@\xb4\xe2:y\x04\xbe\xedh\x0c^\xb3`\xdd\xb8\xc6\xbc\xd8\xabb\xad\x8d\x0eW\xb0\xc3q92\xc9\xcb$\xe8p\x98u:U\x85x\x8d\x8cb\xc2\xe7\xa2\xf5\xf1\xa1\xc4\x94\xee/h\x86\x94\x92=\xd2\xa8\xeb\x94\xac\"X\x17\x1aN\x8c\xfd(*\x0c\x890N\x12\xc1\xb6\x98$\xa1\xb7\xd7^\x18\x00\nP\xea5\x9a~\xe8\xdb\xd99\xc9\xf6\xb8\xc8\x88\x8b\xa0\x8772d\x0c\xcd\xb0\xe3L\xb6e\xeb\xa8\x9f:\x0fsfgy\x8a\xc8\xb0!\xb5\x1f(\xaa\xad\xb9M	\xa8\x11q\x1c\x9bu\x15\x0e\x18\xdf\xeb\x0d\x1d1\x97z\xad[\x10\xeeV7_j'z@_\xf6\x00\xdf\xe9\x03N\xb2\xb1\xb5I`d\xea\xfb\x85-\xd5\xa4\x83N\xd5\xb5=\xc0\x91S\xfbg\xcc\"\xed<nF\xd4\xff\xb6\xfa\x1e\x9cf\x07\x93fC\xc26\x9f\xfb\x97\x1e\x07HRi>0\x9d~u\x8aBU\xe5\xfa\xcb\xb3\x1b\x9d6\xfb\xa6O\xf4\xc7\xb6\xbf\xb1\x17\x0f\xca\x1f;&\xdb\x8b\x03\xbe\")]\x19\xdd\x1f\xaa\x0c&\x83\x93<\xd2\x1f\xef\x1fo\x99rl\x8eR\xfc\xf1p\xcc\x0d\xc8\xea-1\x9b\x11\xbe\xb1\xc74,,j\xfa$\x9e;>\xeb\xa1\xa5'C\xcdx\xae,\x88\xfd\xb7\x07\xf5\xdc\xb3\xcd\x0dSLk\x19X<4-\x16	\xa5X\x7f\x10p\xee\xb1\x800qo\xb1\xdd0h\x14\xac\x16,\xd0q\xd5\x0f\xe4\xb7\xf0F\x05\xf6\xbd\x87\xb6v\x8e\x9e\xf9\x8d\xe6z\xf6'\x03\xef<\xefh\xae\xb05\xaa\xd3*\xd4h\xc3\x18E\xaf\x98\x0c'\x9f\x80FjSq\xa3N\xfd\x18h\xca\x1e\x9dM\xe8@\xf4\x10\xd9\xbb\xef\x1b\xd7u\xfe\xe6s\xa1Q\n\x96\xfbQ\xebf>\xf1\x07\xa5,RT4\xc8\xd4\x82\xfa\xbb\xa8\x95TTy\xbe\xfb\xfe\x0b\x92Jd\x84db\xae\x8bc:\xb6\xbf5\xdc\xd1\xc4\xa0+\x15\xc6\xe5F2\x85\xc3	\xaf\x95\xed\x08:\xdf?\x92\xb0\x02\xff$s\x19\xd1\x0dw5\x13\xed@\x03\xff\xdb=	xoz\x00\xbf\xae\xabTC\xc8:a\x18.\xf7\xf2\xe8\x82V\x96\xcdI\xf2\xa0j5	=\x1c\xef\x1f\x1aR\xc4F\xd62u\xd2t'\xa3c\xa2{\xe8\xd4\xac*\xa9\xf9\x8d\x9bsG\x0b\xdf\xfaDghk\x0e\xaf\xfa\x8b.\xadt\x0f>\x83\xa3G\xfa\x0dK\xa1g\xeer2\\q1\xb8P\xadoCi\xd5N:\xcd5\x92\xbb'\xb5\x17\xcc\x04b\x1a\x1fJ}\x7f\x9aZ\xdd\xc0\x86\xf0\xaa\x01\x7fz\x82\x80\x8b\x0c\x1fZL\xa3\xd0\xdd\xfav\xf1\xb8\x0d6+\xd7\xc3\xdc\x91\xfb\xado\xb8\xdb\xb2\x94\x9a\xefKGSdp\xda\xb9\xd4:\xdd\xed\xbc\x7f\x0f\x00PK\x07\x08qF\xa6\xb8^\x0b\x00\x00\xfa!\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xc3VR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00swift/Rpc.swift.gotmplUT\x05\x00\x01N\xa5\xd4j\xb4W\xddo\xdb\xb0\x11\x7f\xf7_qp;@\n\x14\xb9\xc5\xb0=\x08u?\x966@\x97~\xc1I\xb7\x87\xa2h\x19\xeal\x13\x91I\x97\xa4\xe2\x1a\x86\xfe\xf7\xe1(\x8a\xa2\xec8M\xd7\xcd/\xa6\x8e\xc7\xfb\xf8\xf1wGr2\x81g\xac\xb6\xeat\x81\x125\xb3X\xc2\xe4\xf9h2\x81\x97\xbd\xe0z\x0b\x0ba\x97\xf5u\xce\xd5j\xc2\x97\xecF\x0b;\xd1k>\x1a\x89\xd5Zi\x0b\xe7\xaa\x96%\xb3B\xc9\xd1#1\x07\xce\xe4[7\x91\xf4\x13\x1f\xd0n\x94\xbe\x11r\x91\x1e\xae\xea'G\x8fP\x96b>\x1a\xedv\x90\xbfF^1\xed\xec\x1ah\x1a\x12\x9e\x82fr\x81\xf0x\xa5J(\xa6\x90\xbfWe]a7\x0d\xa5\xe20\x1e\xbb\xe9\xfc\xac\x12(\xedk\xc5\xe1\xb4iF/\xd9-\x13\x15\xbb\xae0Y1\xfe\xf1\x12\x9e>\xc9\x9f\xfe-\x03A\xc3\xbff`o\xfd`\xc3,_~\xbc\x84\xbfgp\x92\x8e\xd6\xf5u%8\xcc\x85d\x15\xf0\x8a\x19\x03\xbb]l\xff\x03[!4\x0d\xecF\x00\x00Q\x84|)*\x17c\xabL_\x1a%\x05J\x8a\xden\x85\xd6\xd9s\xca\xf9'\xad\xd6\xa8\xed\x16\x9a\xa6\x88\xc4\x03G\xc1\x0d\xca2\x18\xd3\xe2\x96Yt\xd6\xacf\xd2\x10\xbe\x05\xcc\xd6\xfc\xaa\xfb\x1a\xc5^\xb9\x92\xb7(\x05J\x8e \xa4\xb0\x89\x9b\xa4\xdf53\xf8y\xf6\xae\x80\xcf\xb3wY\x90\x1a4F(\xe9\xa4\x97\xed\x18\xa6\x90\x9b%\xd3X\xf6jV\xacP\xd5\xb6\x80+\xb1\xc2\xb7\xd2\xa2\xbee\x15L\x07q\xe4%\xceY]YRQ\xb5\xed\x17/\x91\x95\xa8M\x01_.\xad\x16rQ@\xfb\xff\x15\xa6\xf0\xa5\xf8\xea\xf4R\x8f2\xfd\x0cV\xf3\xdc\x05\x7f$\xe3$\xe4\xe2\x07Y\x9f\x87\x1fd}\xc8~\x90\xf5q\xf8A\x9a:\xd7\xcd\x00\xc0\xfb\xfc\x1e\x04\x19\xf4`\xda\xefN\xc8\xe3\xc1\x8c	\xf6\xee\xe4\x0bL\x8f\x11&\xc6'\x0c\xd3\x81\xff\x88J=\xbf|\xa1\xe95\x0f$\x9e}:;\xaf%o\xab\x8d\x96w\x15G\xe3\xb1\xd3\xcd\xa9\xde\xdc\xe0\x95\x94\xca\xfa\xe2=\xed\x88\xea\xab\xa9\x96\x9c\xd6:\xbd.\xcc\xbb\x10az\xe1\x9c\x93\xde+\xbd \xc7\xb4\x8c\xe9E\xb7\xac\xad\x13\x12\\m\xd7\xd8I3\x92RV\x9dc\xfa\xfd\x82a)0\xb3\x95\x1c\xecR\xab\x8d\xd9\xed\xc0\xe2j]QY\x8d5\xdaZKr\xd0&\xd9\xd7;\xfd\xac\xde\x02\xdb0\x11\xd5^\xceYU\xf5\x19y\xa8~\xd4\xca\xa23@H~bv	M\xd3W\x00\xfd\xbe\xecv\x1d\xf0\xa2\xfc\x99\x1dC@\xcc\xdd|\x9ci\xd3\xcc\xd6\xfc\x95^$\x03<\xae\xd4\xbf\x85\xc6\x01biX\xf0u\xe8\xdb%.\x8b\xc3\x18\xda\x89\x10\xc6\x1b\xad\x95\xbe/\x90>\xd5ve~\xa6J\xf2\xdc\xd6\xa7\x13\xb9(\xfd,\xe1\nM\x93S\xb1\xb8\xe0*C\xdf\xc5\xb18\xc3>\xfaA\x98LaGA\x108D\x98\x99\xdb5\n\xb4\xdd?\xd3\xdb\xfe\x16l\x83\x90\x03\xe3D\xbeC\x03{*\x115J\xe4\xaaD\xaf\x19\xd8q`2\xaa\xb0\xbe\xca\x0ezy3\x8a\x1a\xbb\x1b\x978\x17r\x8f\x81-\xa7}\xa0RY\x08\x99\xf63.O1\x07\xfc\x01I\x852\xa8\xa4\xf0\xd4k\xd1!\x02c8}\x0e\xe3v+\x13!K\xfc\xd9\x1b{\x92v\x8c\x89m\xee/N\xfc\xea\x01a4ZbK0u/UH\xbb\xf3\x14\xe4i\xe7\xd3\xd7p\x87\xcb\xe9>0{\xf8\x0f\xb0\xf9E\xf2w$\xdc*\x1eI9\xf9\xf34\x83\xfd\xff*\xd1p\x19\"\xcc\xe3\xcb\x90\xa5\n\"\xc0\x89 \x14\xc7h\xd0\xbe\x8c\xd55\xb7c\xaf\xe7\x97zF\xc6fPkg%\x14\xf8\x113\xa4w\x8f\x15Y\xafZ3\xb2^\x1d\\\xcch\xb6=#\xdch\xff\x90\xf0\x07\x04\xcd\x11z\xad\x92'G\xd1Kfl\xe3\xbbF\x06g\xaa\xa4[]\x06g\xcc\xe0[\x8b\xba\xfd\x9a\xad9\x05p\x81[\x7f\x1eG\x89\xaepu\x8d.\xd7\xd6\xc1{\xf7\x1d\n}\xefTk\xb5]\xd0M\xc3\x99AR\xe8\xa4>6\x98\xc6\xc2w\x82\xc2\xa8z{\x01\xa8\xae\xe07\xc2.\xbd\xf3sVU\xd7\x8c\xdf\x84Cu2\x99\xc0kGk\x03\xb5\xbc\x91\xd4{oYU\xa3\xc9\xc0\xd4|	\xcc\x80]*\x83\xc0\xca\x12K\xb0\n$nP\x83Y#7\x19M\x7f\xa7\xeb34\xcd\xf7\xfc\xe0\xd62\xd7j\x05m\xd5\xe8\xc2\xfb\xd1\xa9?\xf5\xa2\xab\x0b\xdd%5\xdb\xfc\x8b\x1c\xbb\x8b\xcb\xb6[\x95\x1b!\x17\x15\xba\x993%-\x13\x12u\x92\xe6\xedt2\xf3\x8b\xda~\x1e\xec\xd1\x17L\x0f65\xe9|\x14\xc1[\n/^@\xee3x@\xa3\xf4\xb5tJ\xedcr\x02q\xa1\xc0\xc9d\xbfat,n\x9a\x88\x96ns\x8f\x91\xb1]A\x9b\xde31\x90\xee\xcd\x8f\x9aY\x1aF,s\x9b\xdb\x1dzaK\xaf\x96\x08\x84\x1f\x19z\x1cx\x83m\xad1\x8d`PZ\xb76\xf3G\xb1\xdb\xc9\xf6\xbct%\xf9,^\xf9|\xb8\xb7\x86\xaeZ\xdc\xed\x9as\xe2\x90nO\xe1\xbc'\xde>\x0d}\xe1\xcf\x05\xb6O\x95\xfc\x9cF\xc7\n\xc1\xa99\xa8\xfc0\x06\xaci<\\\xb7L\x93o\xaf\xe2\xa3-\"Q|Q\x0b\x98\xf9\xc0\x0e\xe8\xba\xdfs\xef\x08\xf5\xbe\xb3\xe5AA\x04\\\xe2\xab{\xd4.\x8e\xc13\xb8\x91\x0f<y\xa2\x0fd\xa1\x12\xa2t\x87\xe4\x16\xf3\xd8\xc3\xe0eGm\n\xceT)\xe4\xe2\x02\xb7\xa6{\x1ce\xbd\xec\xf7C\x0f\xad\xec\x8e\xd0\xfdU\xb5\x9d\xf9\xe7\xe5\xc7\x0f\xbfL\xe2\x8f\x1a\x0d\xef\xda\xc8^\xa7	\xf2\xe4\x06\xb7X\xfec[D \xec5\x98\xff\xdd\x869\x82\xb6\x9d\xb1\x83\xe0\xb7\xd2w\xcf\x1b\x94\x94Bb\x95\x1f\xe9\x02\xde\xc8c\xcd\x96j&\xc6\x00\xe5\xff-\x7f\x827\xb8\xca}\x94=\x1e\xf1\xab!Yk!\xed\x1c\xc6\xe4(\xff\x8b\x19\xc7h\xa4\xee\"7W\xfa\x02\xb7\x05\x1c\x96@z\x1c\xab \xa4Kj3\xc4\xee!\xd4\xf9S\xb4\xbf\xdd\x8f0\xbd\xa3\xe4\xf6\x02\xb7\x11\xc0\x07\x8d\xaa\xbf\xfet\xe7\x8e?&N&M3\xfa\xcf\x00PK\x07\x08Y\xfd\x1br\xe2\x05\x00\x00W\x13\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00/\\R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00swift/RpcUtil.swift.gotmplUT\x05\x00\x01\x8a\xae\xd4j\xd4\\[w\x1b7\x92~\xe7\xaf\xa8prv\x9a;LK^\xcffw\xfa\x98R\x129\xdeh\x9d\xc49\x92\x1c=ht\x1c\xa8\x1b$1n\xa2;\x00Z2W\xa3\xff\xbe\xa7\x80B\x03}\xa1$;\x9e\x89\x87/\"q)\x14\xaa\xbe\xba\xa0\x1a\xad\xbd=x\xc6\x1aS}\xb1\xe2\x92+fx\x01{\x07\x93\xbd=\xf8*4\\ma%\xcc\xba\xb9J\xf3j\xb3\x97\xaf\xd9[%\xcc\x9e\xaa\xf3\xc9Dl\xeaJ\x19xQ5\xb2`FTr\xf2\x07\xb1\x84\x9c\xc9c\xdb\x91\x84\x8e\x1f\xb9\xb9\xa9\xd4[!W\xb3\xe1\xac\xd09\xf9\x03\x97\x85XN&{{{p\xce\xcb\xf2\x8b\xb7\xb2\xba\x91\xc0\x95\xaa\x14\xe4U\xc15,+\x05K&\xcaFq\x0df\xcd\x0c0\xc5AV\x06\n\x9e\x97L\xf1\x02\x84\x04\xb3\xe6\xa0k\x9e\xa7\x93\xba\xb9*E\x0e\\6\x1b8\xa9\xf3\xa3\xaa\xe0p;\x01\x00\xa0\x1em\x98\x119\x94\xdc\x80\x90\xd7\xac\x14\xc5\xd7j\xd5l\xb84\xb0\x80)5\xbda\xd46\xdd1\xb5\x91\xac1k.\x8d\xc8\xad\x1c\x170\xed5\xed\x9aYs\xb5\x11Z\x8bJ>\xe7R\xb8\xa9\xa1\xedMa\x1bwM\x96\x95\xb1R\xc6I\xb22o\x96\xf8c\xd7`!\x0dW\x92\x95op\xb4\xff1\x9d\xdc9y\x9f\xfct\xf4\xad\x95\xb3\xd0\xc0\xbc\xcc\x15Ge9\x18X\x99ru\xcd\xd5\x1cn\x84Y\xdb\x86\x82\x1b&J\x0d\x0c\x95\xc1\xb7p\xc3\x15\x07\xcd\xa5I\x1dQ\xae\xebJj\xae\xed\x8c\xaa1\xc0@qV\xb0\xab\x92\xd3\x12\xa4\xad\xab\xaa\xd8\xceA7\xf9\xda\xd1\xaa4\x87\xa5\xaa6P\xab\xea\x9d\xe0\x1a\xc7-U%\x8d\xa5[-;\xec \x04ZVq%`\x16.Pp%\xaey\x8137v\xc6wgg?Y\x994\xba\xc5\x866\xaa\xc9M+\x80\x0c\xac\x1c\xe6p\xd4hSmN\x8d\x12ruT\xc9k\xae\x8c@\xbe;\xf0A\xdcl\xb8YWE\x06nh\xbf\xd7\xad\x96\xc1\xb14\xfd.\xe4q\xd7\xb4\x0d\xd7\x9a\xadvv\x93\xe038\xa9\xf3\xff=}\xf5\xe3\xe1$&p\xcd\x14\x14\\\xe7J\xd4h\x7f\x9e\x08\xdc\x12\xaf\xf0'\x98f0\x85?\xf9e\xe0\xce\xe3\xe0l\xad\xaa\x1b9\x84\x02\x93\xa0\xea\xdc[\x99S(\xfcbp\xb4\xfe\x85\x10!\x8cn\x11Qp\xdc]\x91\xc2\x113\xf9\xda\x92\x16f\x08\x1c\xb3\xady\xd0\xfb/9\x0e\xb6B\xe5JaK\xc4\xce\xb3c\xc37?\xa0\xad\xc8\xd5\xc1/}\xf5\xc5\x03\x9f;\xe1\x1c\xfc\x8b\xa9\x92\xd8\xfeh\x9aTLj\xb4\xdfV\x99V[\x12n\xd6\xdc\x19\x9d\xe2\xbf6\\#\xf3MY\xa03\x81+4*i\xa0R +Pd\xbep\xc3\xb4\xd5\xa0\xe29G\x83\x8a<\x80C\x875\xb0\xd7'\xdf\x9fr\xeb\xca\x06\xca\xe9\xb0\xf2\xb1\xd5\xd2\xc8\x82\xabr+\xe4\x8a(\x7f\xa0\x04]G\xe2\x0c\xe7\xca\x92\x0b\xa4gA\xb0\xcf-\xb4G\xa5\xca\x10\xca9\xd7z\xd9\x94A|\x1d\xf9\x92a\xcc\xa1\xd1\x0d+\xcb-\\\xf1\x9c5\x9a[\x01\xa3i\xe4\xa5@\x150Y\x90\xbfu^5\xc4d+\xecB,\x97\\\xe1@\x0ct\x03o\x16\xf1\xf8\xb1\xc5}\x8f\x15|\xa0&\xec \xfc\xc4\xde\xc9\xca	\x15\xf5\xd7\xc4\xad8k\x05\xfa\x18uY\x9a\xad\xcaN\xea\xbc\xc5 \"\xbc\xd0\x90\xb3\xb2t\xf9\x84Y\xc7\xd2u\xe2\xd7s\xb8Y\x8b|\x0dz\x8d\xd1\xa5\x92\x1c#5\xa8\xaa24\xc2E\xb8\xb35\x87+\xa6\xf9\xeb\x93\xef1r\xde\xac1\x00\x86\xd8\x84m\x9b\xaa\x91\x86\x17\x91\x97[\x1bS\xeblo\x8f\xbfc\x9b\xba\xe46\xb5b\xb5\xf8en\x95n\xd6\x0e\x0bk\xce\n\xae\xb4\x8dn\x18R\x9d\xcd\xf1k\xae\xb6\x96\xf9t\xf2\x15\xbbf\xa2\xc4`\x9alX\xfe\xea\x14\x9e\xec\xa7O\xfes\x0e\x02\xbf>\x9d\x83\xb9\xa6/7\xe8V_\x9d\xc2\x97s\xf8\xf7\x99G\xcaRHVB^2\xad\xbb\xf2q\xfa@8~/6\xe8\xd3q5=\xc7\x10\xacy^\xc9B\xcfa\xc3\n\x8eY\x01I\x0br\xc5\x99\x8f\xbe.\xce\x1b\xb1\xe1Uc\xd2\x1d\xc9V\xc1\x97\xac)\xcd\x99\x1b\x95\x01~9\xc6\xa4\xe4\x9a\x95\xb0\x80\xa7\xfb\x1d\xe8\xa0\x9f$1g\xe8g\xfa}\xda\xf9\x9d,\xf2A\xfd!fl\xa5\xfe \x92y\x06\x17\x0e]\xde\x06.;\xdc\x08)L\xd2\x826\xe6k\xde\xb6\x8ep\x04\x0bH-\x9c\x8a0l\x94+Xt\x14\x92ve\x15&\xef\xe4\x16\x16p\x91]\xdaq\xb3\xc8\xbc4/\x97)\xb1\x0b\x0b\xcfx\xb7\x9b\xf8\x86\x85\xdfA\xb7\x9b\xf8\x85\x85\xe7\xbc\xdbM\x1c\xc1\xc2\xf3Ffh\xff,\x1b\x99[,=;;\x08\xe2{CV\xefE\x1d\xb6\xf7\x06\x98Z\xe1\xeeN\xea\xfck\xb5\xba\x0c=\xce\xe5FZBq\xd9\xb6h\x10q0\xd4e\x18b}\x0c\xcf\xe0+\xaesV\xa3\xb3I\x84D\xf8\x9e\xd4\xf9	7\x8d\x92z\xe6\x16\xd3\xf0\xc5\x01\x9c\x91D\x99\xde\xca<n\x8fd\x8cN\xce\xc7\xd5\x05\xc2\xf1\xc4\xfdH\x1aUf^\xe4)\xabk<\xeb\xc8\xd5O\xcc\xac\x8f\xaaM]I.M\xe2$1\x9b{\xe1z\x83\xc8|\xc3\xac]\x87\xd6H\xd1\x97\xfc`\xa7aZ\xff\xd3\xab\xd3\xb3i;\x06\x0fK\x89d\x1b>\x87kV6|\xe6L8\xe8)\xddp\xb5B'J\xb2\x9aC#\xc5\xaf\x8d\x90\xab\x97|\xab\xcf\x85Ygp\x0b\x9f?\x81\xbb\x18F\xf1\xf2\x9a\x9b\x9f\x91tb\x17\x98\xe3\x92\x98c\x7fg\xe9\xbd\x10\xbc,2@\x0e\x02\xe3w\xed\xb7\x01\x8d)\xab\xebR\xe4\xf60\xb9\xf77]\xc9\xe98\xc1\xe9Q%\x0d\x97\xe6\x8b\xb3m\xcd\xa7\x81tQ\xed\xe0\x12\x85\xf4MUl\x11\xb6j\x0b\x980\x7f+1\x88\xabd\x96r\xfb-A\xacE\\\x82KE\xbb\xf4\xac\xca{\x89\x15)-#\x18\xcf;A\xd0fG\x11\xd5I\xfb\x15\xbdMR0\xc3\xe6mX\x9b\x11{\xec\x86	\x17\xa5\x12\x12\xd1\x9c\x88\x07J!\x10\xc3\xa2%\x90\xa2\x7fm\xf4QU\xf0\xee\xc0&\xcf9/\xec\xe12\xf9\x8f\xfd\xfd4}\xf6t\x7f\x7f\x96\xe6\x954LH\xed\x03l\x97;g\x1c\n\x16V^.\xa1PI`\x81\xfa\xd3Fsu,\x97\x15\x9a\xa9U\xcc;\xf3\xec\xec }\xcb\xb7\xe8\x87B\xe3@P>\x91p\x7f\xe7\xadU\xbb\xbfsZ \xa3\xbf=\xee\xb8\xbc\xe6eUsk\xfb\xdf\xd2\x8fgg\x07\xbb\xc1\xe0g\x90\x94=\xfb\xeeo\xd2\xa5\x92\xa27\x9b\xdbL+\x03\xd4\xd2C\xc8\x10K\xf8,\x88\xb9\x8b\x9a\x80\x9c\x8eW\xb7\xd8x\xd1\x02\xc8\xcb#\xac\xd4\xb5\x96@%\xca\xed\x06\xe0\xeb\xcb\xf4q`\x14K\x7f\xe6\xaaP\xdf^R\x8e\xc5\xden\xacv\x1c\xb0\xdb\xf6;\xe0\xa5\xe6\xf7\n\xe1C\x04\x10\\\xc5\xaaa\xaa\xb0<*\xe7\x96c.}\x93\xe5\xe1\xf6#\n,D\x81\xd4\xd6c|Z\xd9U\x8c[\xde3\x16\x07\xbcZ\x89kf\xb8\x0b|\x98u&o\xbcK\xca\xa2\xd00\x1f\xc4\xc0a\x88I\x9e[W\x81\xbe\xd5Nt\x06\x1f{e\xc4\xb4\xf3\x1c\x98'\x1e\xady\xfe\x96\x176(\xbat\xdf\x08\xd9X\xcf\n\xb7\x90\xc7?E\x08\xf1Q\xe2\x92\"\xea\xcf\x98~\x9b \xb9\xcc\xf3=\x83[\xe8z\xad9\x1d\x01{d\xc6`5\x82&\xff\x899J\x15\xd7\xcd\x86'\x86\x98\xcf\xde\xdf\xe3\xce&=\xfa\x11D\x1d\x8a\xe8d\x1b\x9c'0}\xd8\x97/\xdc\x0e\xe8\xecb\xd6\xa9\xdf\xb2`\xdd:\x1c\x1e\x02\xea,\x99E\x0e~'[\xe3\xeb\xc46\xf9\xfa\xe4{g\xf1\xe9\x15+Nm\xe1\xcbs9{4\x93\x1fW\xa2\x9de\xef\xbc\xd6\xc2\xc0\xbb1[\xa0z\xab5\x89\xd6\x03\xf6\xf1\x8f\x16\xe1\x0d\xf3X\x9a\x19&_\xbe>\x17\xc9j\xb4\xf0\x82\x1f}#\xb0\x8e\xe4HD\x13r\xa69\xfcy\x7f?\xb3\xd3|t*x\xda+\xfd\xf6'<\x19L\xe8Uw\xfb\x13\x9e\x0e&\xf4\xeb\xbc\xfd\x19\x7f\x1e\xcc\xf0\xc5\xddv$\x1d\x03\x06\x03\xdb\xc2n;\xf2n\xd2\x89\xff\xbe\xc8\xb7\xe8\xe3;-\xab\x9c\x95\xe2\xffxA\xb5\x8fe\xa5N\xdb\x1c\xc2;\xc5Y\xdf\xd5yU<\x18}P\xca\x8e\xdd\xb9g\"\xf3_\xe6\xbeT\x98\x81\x14\xe5\xf0\xbc\xfe\xb5ZQ\x01\xd2\x17\xdf\xb1\xec\xcb\xec\x01b\x0e.i+\xf08\x8d'\xd4\x1b\x81\xc7\xeem\xcd\xd3\x89/\xe7\xda3C\x066\xcfcQ\x99\x83|2\xca\xc5\x11\xb1\xf9k\x06	e\x84q\xc2\xffs%\n'J<\xf2=;\x8f\xc8\x1d$o\\R\x9d\xc1y\xec\x86#\x9a\xb0p#(\xc3\x1c\x1c\x85(\xf14\x15}S\x19\xf4y\x08t\xd1\xbdG\xb4\x13\x9a2R\xe7@\xee%\xa57\xbaSlE\xf9\xed\xaa\xe7\ni*`q\x195\x16%\x11u\xec,E\xc9c1\xfa<-\xa1\x1cq\xeeOrh\xbb\xe1\x87\x0fmA\xbcQ\xb1\xce\xca\x97\n\xa0\x99\x0b\xd8^\xca\xa8\xd6\xb68\x9ab\xca\x1f\xcb\xdb-\x0e\x0b\x8cK~y\x9f\x89\xfb$\xa8\x03\xc0~\xa0\x8av\x1c\x0e\xa5\xfe\xd3\x03\xf8\xa0\xbf\x07\xf8A\x7fd\x00\x83\xbe\x81A\x0cF\xb4\x06\x82\xca'\x01$\x94\x93\xf2H\xfd\xfe3t\xbd\x01\x16t\x9c\xa5\x89\x9a\xea\xbf\x98\xb28\x90\xea\xd8\xba\x84\x84J\x15\xbc\x03\x01O`\x88\x01r\xe9hQ\x94&\xc1\xc2\xa9P\xc8\x95\x15lj\x97\xf8\x91\x1cZ\x10\xf3\xd7r\xeb2\xed\xb6\xa5;\x8d\x8e\x13\x89#\x85g\xe5\x0c..\xd1s\\5\xab\xe7q)q\xeaW\xee\xec\x89\xce\x86\xb3\xc9\x00\xb7\xd7\x0c\x9f(\xda\xe3\x0f\xda\xddk\xf9\x96oy\xe1W?\xf2=\xf4Xe\xd3\xe0\x16\xe5\xca\x85-'\xc2g\xe7\xa38='\x84\x06\x94\x9fGhuylgqX\x04Fv\xa7\xb0\xc3Tt2\x8c\xc3\xa8\x01+i:\xe2\xb4t\xfd!\x07Y\x0c A\xc9\xa7\xa3|\xb4Cbi\x0e E\xdaA/\xbdf\x12\xcf=\xa6\"\x7fC\xa5[zN\xd7\xa6X(\x94f\xe5\x1f\x1d\"K\xea\x8f\x1a\xfc\x012\x9dx\xaf\xd2+M\xd2B\xcf\xce\x0eH6\x848\x94\xe3[\xbe\xcd\xe0\xc8.\xf7\x9a\xe8\xbc\xe4[\xb8\x1d\xb6%\x8a\xdd\x90\xaf\x9f\xaa:\xb7;\xe7\xef\xcct\xf6\x99\x8f\x97;\x1e\xe6\x8d=\xfa\xc16\x7f^\x1d\xa9B\xb5cZ\xdfx\x7f]\xa9\x8d1mH\xf5^\xd3{\x18\xebJ\xefYq\xfe\xde\xc5\xac\xd8\x8bZ(P\xf5}A^\xaf\x8b\x13\xca\xa5\x16\xc4P\xb7\x93\x1e,-\x88\xc1ng\xeb\xa1\xdd\x97\x01\x8c\xfc\xe1\x1dq\xd4A\x8b{&\x8c\xc7\x0f\xe0\xc2\xac\xb9\xb2\xdd6i\xc4\x07T\x01DE\xd7\xea\x03\x8eB\x00\x8b*\x04Qx!	 \x8ex\xf4\xec\xf7\xb0m\xa5C]\x06g\xfe\x01+!\xd4^(p\x10\xc3RYH\\\xdb\xb6H\xba6\xc5\xb3\x0b\xcc=\xc5^Pj\x93!\x92N\xc0\x03\xba{\x92\x9c\"\xceG\xf3\x83pB&\\\xc3\xe2Q\x15\x1a<\xf5\x04[F\x13\xdb\xe9\x83\xba\xbe\x19\x0f9G\x95RMmx\xe4\xd2\xfd\xa7;\xf8C\x1cy\x9d\xfb\xadD%\xbe~\x98k\xbf\xfa\x9d{\x9f\x8a\xfe\xcf\xef\xbf\xf5q\x89\xf5\xf2\xdf\xb4\x1e\x03Ug\x03PX\x00\xaf\xb0\xf8\xe1~\xa2NR\xab=,\xcc\xaa-|F\xcdm\xf1\xe8GQb\xe6\xfc\x12]\x11\x8d\x8c\xb4\x1f\x9d\x84I\xdbh(j{8\xf4\xcf\xce\xac}\xf1\xe9^\x8a\xf8\xd9\xdb\xc3\xaa\x82\x01\xa6\xa1.\x19\xd6v\xed|\x8d\x0ff\xaa\xb2\xe0\x8a\x1e \xeaIo\"\x19\xd1\"d\xf2\x83\x11\xe1q\\\xe6\xd5@\x0eb\x98\xa7\xc4\x99\x90\x1f\xbb+#\nY\xd1\xe0\xf82Nxpn\x18\x1d\xd5\xa6JR\x94\x83\x01]\xf8\x84\xb8\xd6i\x8e\xb0\xe4\xf1\xb4\xc4\xfa\xb5&0\x05eI\xae\x0d/\x8e\x1e\x04\xd5@\x85\x83\x05\xc81\"y\xb7\x16\x01\xeax\xf9\x93\xe2\xa8\xda\x1d\x88\xc013\xac0L\xa7\x03\xa6;\x18{?\xb24u\x17e\x92\xf1\xfd\x94\xe9>J\x1f\xc34\xb7+\x02*\xc6\xb4\xd1\xc3c\xc75\\\xe0./[\xcf\x08\x9f-P\xb9\x03\xf5S\x94\xc5SL\xe1\x0d\xcb\x11 a\x0e\xb1MB\xd1M\xcd\x15y\xd4`\xbf\x9e\xd3!\xce\x1e2\x83\x87\xa0\x8f\xcc\x0c[I\xe8\xfd\xf6\xbe\x07\x89\xad\x96.\xe1\x14\x93GU\x92\xf6\xf6Z\x11\xda\x0bsE%\xff\xe8\xab\xfb\xf6\xe12\xc9\x9f\xd9\x8b_\xde#\x80\x90\xdapV\xecd\xe2\xf7t\x1d\xe3\x92\x8c\xa4\x19\xaa\x0c\xa3\xa3H\x1e\x99\xff2\x18\xd4\xc5i\xb7\xd2\xd5\xf3\x1d\x91\xdf\x882\x06XD){\xf2`x\xa1\xac\xe0Q\x01\xc6\x8f\xed\x01\x84\x9aC\xb0\xdb\xe1\xb7\xe8\xa8\x13\xdcW\x8b|Ox2\xdc7Z\xb3\xef\x8f\xe8\xa2f\xc9\xc8\xfe\x8dz\x87\x05	t\x07\x98\xdb1\xe9\x9e\xbc\x01=*\xf4\xd7!z\x15\n\x1b\x9av\xdc\xedlo\xb9\xf8\xfb\x9cH\xdb\x06s,\x16\xcc\xe1\xdb_\x1bf\xa2b\x8fM\xbcdS\xba\x90`\x7f]UU\x99|SU\xe5,\x1e\xb1\xb9\xe2*y^5W%\x9d\x8f\xecX\x17N\xc9YF\xedL)\xb6M.h\xfd\xcb\xa8\xa7\xba\xfa\x1b\xcfM\x12\xe7\xe74dp\x7f\xe0\xb1\x89\xdd}\x89\x0d\x9exKW\xc0\n\xfa\xdc\x016\xa7'DR\x1f9\xa8\\X@\xda\n*r'b\xd9?U\x8e\xa4-(N\xeb\xf0wQ\xb6B\xb7j\x9f}\xd0\x02N3\xf7.A:\xfc\x0d\x8bD\xa1v\xd7\"\x04\x88\xdf\xb0H\x0b\x9a{7\xe3\x006\xbe\xcc8g\x04<o\x98\x9d\xacr\x80F\xb7vD\x99l6\xc6\xe8\xfbW(\xfbU\x0d.\x1f\x05R_\xab\xc7\x8d\x84\xbdYk\xb2\x88\xccz^\xcc\x91\xb58\xee\x8eF\x9c\xc6H\x1b\x9f\xd8\x97j\x98\xdaA\xd0\xfbN\xee \xe3}'\xc7\xea~\xdf\xb9\xa4\xf8\xf7\x99|7p\xd1\xaf\xa50\xfe\xf8\xdd\xe0w\xba\x96K\x87\x0b,\x1aoj\xb3%\xf7\xd6\xbfkH\x04\"?\xfc\x1d\xd3\xeb\xb8\xe6\x1e]\x9b\x9a\xc1m\x17h\x8f9\xe5\xfeVh\xe2\x95w\x0f\xc7|\x98\xb7\xe3\xb3\x02\xb9}\xc9\xb7\xd1Y\xd0F\xb0P?p\xfd\xd1\xb9\x91\xa8#\xe8\x9d\xee\xa9\xb4\x14]\x01\xc6>!\x0du\x1cKC5\x04\xdc\xf1a22+v\x07\xc8	\xa1\xeag*\xe9E\xbfb\x83u\xe4:\x0b=@\x88\x1e\xf6\xf8)\xc1\x8e\xecX\xdf\x0c\x8b\x96\xfb\x180\xf6*\x12\xde\xc7\xd7\xa2p\xcf\\\xa2+\x8aL#~\xde\x85{\x82\xf6\xba\xa2\xbb\x15i\xe3	\xd3p\xf2\xe2\x08\x9e>}\xfa\x17\xda\x8f\x8e\xa4\xfcZ\x8awx\x11\xae\x85\x12\xed\x03%I\x0f[\x9e3\xc3\x83\x18\xc3C\x18l\x1fl\xdb\xfb\xe2\xebh\x13a\xeec#\xb0\xa7\x82K$&\xba\xa7x*d\xce\x9f\xfc\xe5\xbf\xf6\xb3GD\xe5\xb1X6\x1b\\\x8f\xfbgx\xdc\xdd>\"\x1d\xdd\xdd\xa8A\x8c\xa9)\x94\xdd\xda\x97N\x96\x95\xda0cp\x1f\xc7\xa7\xaf\xfe\xfb\xcb\xfd'(\xc5\x17\xbe\x15\x9f\xdb\xb4\xe8\xeb\x8c\x87\xc5\xe8\x84h\x1b-\xe9\xd4}{e\xdfu\xc0\xf4\xf4\"\xc5\xa2\xa1\xd5\x92\xe4\x06\x17Dn\xe7`\x9b_(\x96\xe3@V\x9e\xba\xcb\xac\x97-E\xaa\"\xb6\x84m\xc7]2\x9b\xec\xda]\xcd\x94~\xf4\xd6\xdc\xe0\x87\xf7\xe5\xc6=rS\x03\xe6\xdd\xec.\xe7\xbf\xaf\xf9\xfc\x86\x04\x16\xa7:/18\xcc\x8c$m#\xb5P\xbf\x03\x02lj\xa5C\xaew\xf6A\x95\xce\x1dg^!\xb3\x10w\x87\x87\xce\x91\x07V\xfc]\xcds\xbc2\xdd\xfaC4\xbe9\xac*c\xaf\xbc[\x16\xbb\xe5\x9d\x9d'\xb3\x9d\x8a\xfa\xa7eq\xa3>\xc5K=X*\xa5I\xe8y3\xba\x93\xda\xf1\x81{{\xb0l\xed\xd3G\x11\x1b^\xac\xe6\xf0\x8d\x88\x9a\xe1=\xfdrK\xef\x9c\x05k\x85Jbk\x9e\xf3\xdah\xd8\x88\xb2\x14D\xc0\x93\xae$\xe8j\xc3\xb1Xjp\x9a\xbb\xe4_r\xf8\x9f\x8a^\nhj|\x86%\x99\xac\xe2\xa9=\xcb\xb7B\xb5\xfc$o\x08\x9e!\x82\x7fq`\xe3\xc4a\x84\xab\x90#\xb4A\xbc\xd3\xe7w<\xb8\x04\xbf\xdf\x0e\xa3cXQ\x99\x96D\xba\x14J\x9bcY\xf0wI\xb5\xcc`\x9aN\xbb5+\x9c\xc0\xed\xbb\x81n\xc2EQ\x994M/\xd3BU\xf5\x0b\x9c\x9c\xccb\"6J\xe3}\xdf\xcf>\xdfO\x85\xfe\xfa\xf4\xe8\xf8\x18\xfe\xfew\xfa\xf9\xa3\xcd\x8e\x87\x17\x81=\xf3\xf8\xc8\xd7F\xb6d\xba\x1f\xbd\x9d\x11/\xfd\x8c\xcb\xe2rf\xab\x8bag\xf8q\x83R\xc57\xd55?m\xae\x14\x93+\x9e\xb4s\xc6`O\xae\x9a|e\x81\x91\xd9\x81\x8al\xfb0e\x05Zp,\xd1\xc4\xf3\x1a\x054,\x8e1\xc30\xf9\xf5\xd9.\xde\xd1\xfe\xf2\xcf\xee]\x0c\x97\xf6\xda\x11\x1b\x86\xaf\xea\xb4\xa3\xf0h\x12e.x\xd5\xeb\xfe\xac\x85\xed\xc8Z\xd8?(k\xf9\xc7\xd7\x0d<\x9f\xb8\xb9(~\x85H\xda6\xddM\xee\xf1\xcdv\xba\x13\xbasKE\xf6\xb0\xaf\xff-\xce\xbb\xeb\xab\xc7|3]\x07#(X\xfdOw\x96\xc5v\xaa\xeb\xf7u\xbe\x96\xad\xb4#W\xca\xfb[\x97ko\x87\x10\xc6K\xa1\x0d\x16\x80\x0b\xd8\xb0Z?\x80\xf6\xef\x85\xb6\xb7\xa1\x08\xef\x07\xf7 \xff\xe2\x9c\xde\x9d\xe9\x02\xff\xe2\xfc\xf2\xd3\xc3\xbdg`\x1c\xfc\x87pq	;\xa0yqNE\x95OL\xff\x91\xa6\x83\xaf\xfa\x81\xd5\x8fU\x9e\xaf\x1c\xedPb\xe8\xfe\x17Tf\xb6[\x9ba_\x9f\xbaV\xa9\xc6\xfe\xadl6x\xe7@h\x10\xf8J!\xfe\xaf\x02\xf7\xda>V\xca5h{1\x07\xdf\x1b\x94\x18\xc4\x1aL\xa5\x18Zy\x8d\x17h\xda\x97\x1d\xa3s\xbc\xa5L't\xb8Y3\x83'y$b\x8f\xfc\xfef\x0fR\xc7\xeb\x16\x95\xa9\xf2\xaa\x8c8\xc9\xfa5 T\xfba\x82\xf74m\x1d%\xae\xa9c\x0e\xd4\xeb\x80[Xq\xe3\\\x14\x7fg\xb8\xc4\x8b\xb3\x11yz\xd1\xf2\x94\x97\xcb\x0cN\xd8\xcd	\xaf\xdd\x03StGsl\xa1Z\x87/v\xdc\xcbE\x1f\xbb\xc8kt[\x88x\xeb\xa0`\x9cg?\xe7\xa30~,\xcdc\xb9\x8e^\x8f\xf0\x1c\xd8\xf9^\xde\xa3\xd1\x92R\xa8\xf8\x81\xf9\xdd\xbdr\xf0\xb4\x1f!\x08\x8a4\xed\x8c\xbe\x0bB\xbc\xa2\x1bzI\xaf\xefX\xd9\xcc\xe1\x91^\xe9\xe5N\x87d{>]_\xe4_\xca\xa4\x94~<\xce\x8ci\xa9m\xba\x9bt\xdf\xee#\xd1\xcfA\x18\xbe\xb1\xef\xf7=\xce\xa3\xf5V	\x00z\xcb\xf1-\xb9\x97\x9ep\x06\xf7A\xe8=\x92\xae\xcer\xfe\xf3\xc0\xa9y\xc7\xc9\xb9\x91\xee\xff\xc2 \xab\x7f\xf5\x8c\xf6\x8e\xca\xdd{B]l\xb7\x1a\xb9\xa0w\xd4Pv\x83\xea\xf8'\x90\xc2=\x17\xf6\xb8\xc2\xd46\xb1\xefer\xffV\xa65*M'\xe8t\xc3j\xb8\x85\xe4\xf3}|\xe7.%y\xcc\xe1\xf3\xfd\x94\xde\xfa\xbc\x9b\xcd&\x00\x00w\x93\xbb\xc9\xff\x0f\x00PK\x07\x08\x7f\x8c\xfa\xf77\x12\x00\x00\xf5G\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00yTR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00ts/rpc.ts.gotmplUT\x05\x00\x01\x07\xa1\xd4j\xacV]o\xdb6\x14}\xd7\xaf\xb80\x86\xc22l\xa9{5\xeavE\xd2>\x0ck\x1bd\xd9S\x10\x0c\x8ctm\xb3\x95H\x97\xa2\x92\x18\xaa\xfe\xfbp\xf9%Y\xb2\xd2\x0c\xdb\x9bD\xde\xafs\xee\xe1%\xd3\x14\xde\xb0Z\xcb\xd5\x0e\x05*\xa61\x87\xf4m\x94\xa6\xf0[\xb7p\x7f\x84\x1d\xd7\xfb\xfa>\xc9d\x99f{\xf6Mq\x9d\xaaC\x16E\xbc<H\xa5a\x01\xac\x82\xebC\xf6\x97\xe6\x05l\x95,a\x96\x90\x01\xfd'_\xabY\x145\x0d$\x97\x98\x15L1\xcd\xa5\xa8\xa0miq\x05\x8a\x89\x1d\xc2/\xa5\xcca\xbd\x81\xe4\x93\xcc\xeb\x02\xfd6\xe8*\x97\x99\xd9M.\n\x8eB_\xca\x0cVm\x1b\xe1\x93I\x9c\x15\xac\xaa\xa0i\xfa&\x9fY\x89\xd0\xb6\xd0D\x00\xbd\x0c\xd9\x9e\x17&\x875\xa5?\x85\x82\x12\x01(d\xb9\x14\xc5\xd1D2\x86\xc9\x95\x92\x07T\xfa\x08m\xbb\xee-\x9f\xa4p	P\xe46\xccA\xf1\x07\xa6\xb1\x0b\xa7\x15\x13\x15\x15\xba\xf6\xec$7~)\x8a\x002)*\xad\xeaLK5\x97\x07CLg\xf9\xc5.\xc0\x8f\xb1ol\xc0\x01\xe8=\xaf\x92\x90\x046\xe0\x82\x00\x17\x95f\"C\xb9\x1d;\xc3\xbb`\xb6\x06\x81\x8fc\x0b_K\x1c\x01\xbc\x98CW\xcdY\x06ac\x12M\xd08?\x85\xd1e\x0d\xc4z\xa6\x9dX\xd4!\x0b\x8d\xbc\xbe\xba\xf8X\x8b\xcc*\x86\xfa\x01\x99,K\x14\x1af\x003c\x9b\x90h\xcc\xc7{!\xa4v\x02$\x15\x01\xb0\xea(2\xf22\xfbN:\xf3P\x81K\xc8\xd4\xce$$\x9b\xf7jG\xc9\xc8\x85\xa9\x9dw\xb1\x1a\xa1\x85\x9b\xe3\x01\xfd\xea\x92V	\x85M\x06\x9e\xf8w]\x97/XQ\xb8N\xc7k\xb8R\xb2\xe4\x15\xbe!\xe9cy(HM3\x85\xbaV\x82\xe2Z<\xd0\xb6o\x9d\x00\xec\xd6@\x07I\xc6\x8a\xc2b \x14\xf0\xbd\x96\x1a\x8d'\xb1u\xc5\xf4\x1e\xdav\xe9\xf6o\x9b\xc6\xa3\xe4\xf9\xd3r\n+\xdf\x9a\xfd>\xa6\x8e\x03*-\xf9 2\x99\x1b\xf6\xfc\xb2#!\x0e\xf6w>\xa9\x0bHt^\x1b\x04\xc4\xe8\xdc\x82\xa9\x8c}Q\x91\xeb\xbc\xf3]\xc3\xcf8\x81\xcd\xdbS\x9b\x1c\xa9\"\x97 \x98\x9d\xa9\xe1\x83RRQ	\x0d\x0c\xd9\xd0{%\x1fE \xa4\xb3\x9c\xa6\xc4\xd1m=\x93\x0bK\x8a)\xdf\xaf]\xa2\xa3*\xb8A\xdb\x81n\xbae_\xab\x93\x8d\xfd\x8d{\x07\xc2\x9d\x906\xea\xfd\x98\xef\x1c\xb7\\\x0c\xb4\xb3\n\x13\x8boAH\x0d\x81|/\xcf\x07\xc9sgb\x8a\xe1[\xc0\xef0/P\x04\xdb\x18~\x0d\xe6M\x03s.r|\xea\"\xbd\x8e}\xdb\xfbq\xbc\xc3Hl\n5q\x1b\xdc\x9f%\x96\xac}\xf4\xb0~\xe7\xf3\xb8S\xe6\x89X\x0d\x99\x18\xa8\xe1\xe7d\xd4\xc2\x92\xf8\xdf\x19	\xfd\xf6\x12\xbf}}\x17\xf7\xa3\xfe\x8f\xfc\x8cs\xd1q\xb4t\xde\xf5\x8e\xa2O\xff\x02\xda\xc2\xa5=#\xad\xf5\xc6\xa2>\x1e\xd0\xb4\x8f\xf4E\xc5E'\xe7Oa&U>sv\xce\xd5)\xb6\x1f\x06\x952Q\xc2\xe1\x9a\x08Cv\xd4\xd5t\xb1\x80k\xa4k\xb5\x82\xc7=\xea=*\xd0{\x04$\x7f\xe0\x150\xa2\x85\xcc\xbdZ\xc0\x1d\xe3\xfb#0\x014\x07\xf4\x9ei\xb0\xd8\xb0\x02\xae\x13X\xa4\xfeQ\xb1\xadEFc\x1ax5\x8837)\xd6P\x8boB>\x8ax\xdd\xe5\x0c\x97\xa8\xc9d\xa0\xbc\x19x\xdb\xa1\xedF\xb6s<sOw\x01\xe0\xd5+k\x97\x98y\xb1\xd9lz\xe3\x9c\xe0\xb9\xe1\x12MS+\xea\xd2r+\xear\xf8\xaa\xa2M{7\x9a\xaf\xe1\xe5\xe8\xd80M6H\xc8\xc8A\x81\xcdhR\x96X\xde\xa3i\xa4\xb5\xfcd\xfeG\xb3\x12~\xf8;\xd1\x1cb\xe7\x96\xfc\xc15*V\xf4O\xb6m\xf4\xcd\x1e\xa1t\xa1\xe4vT\xc8\x128]\x7f\x08R\xe5V\x06G`\n}k\xf3~_\xcdk\xeb,\x14w\x18\x9c\x1e\xa7\x91\x9c{`8\x00\xc4\xe3	\"\xc7\x94\x1d\xfc#\x94K\x97\xd2\x0fp`\x95\xad/\x1a\xa9\xd0\x0e\xaea\xd9\xf3\xaf\x95\x14k`\xe2\x18\xaf\xc7\x98zB#\xbb\xa8\x1d\x87Eq6\xec\x03+j\x1cG\x8c\x83\xe8\xfb\xb1\x8d\xf1\xa9\xfa\\sW\xc4E\xbap}p\xaaZ\xa4\xe3\xeb\xc9\x8e\x087:\xcck\xdfP9\xa5F.4\xaa-\xcb\x8c$\x93\x89\xa7\xfe\x96\xa3}\xea'\x1f\xe9k\xaas\xc6\xccds\x9f\xfd\x9cT\xbf_wi,+\xd6\xb4\xff\xca\x1b\xb4\xf2\x99\x0e\xfaH\xf4D\xf2wp(\xf1\xefp.\x06\xad\xf5^}\xe6\x9b\xe1\x13\xf5<f\xe8\x95<\x85\xa2\xbb1(\xefm7aL\xc8\xe4\xf7?\xbf|v\xaew\xf12\xa4u\xc2\x058\x8b8\x88\xeb\x85\x88;\xd5=/\xb8\x7f\x03{\n\xc5\xa8\x8d\xdd\xcb\xd5\x94\x91t\xdb\xbe\x96	\xd8\x9e\x07/v{\xe1\xc1\"m\xdb\xe8\x9f\x01\x00PK\x07\x08\xd5,:\x1f\xb3\x04\x00\x00Y\x0f\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x97TR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00ts/rpcUtil.ts.gotmplUT\x05\x00\x01>\xa1\xd4j\xbcZ[\x93\xdb6\xb2~\xd7\xafh\xabN9\xa4\x87\x91&\xe5\xd4\xa9s\xb4\x96f\xbd\xb6S\xf1V6v\xd9\xf2\xee\xc3\xec\x94\x03\x91-	1	p\x01pfTc\xfd\xf7\xad\xc6\x85\x04)\xce\xd8\xc9^\xfc\x90\x11\x81Fw\xe3\xeb\x0b\xba\x81\xcc\xe7\xf0\x8c5F~\xbbC\x81\x8a\x19,`\xbe\x9a\xcc\xe7\xf0\xc7n`s\x80\x1d7\xfbf3\xcbe5\xcf\xf7\xec\x93\xe2f\xae\xea|2\xc1\xdbZ*\x03\xe6P#\xbc\xc4\\\x16\xa8\x9e\xadW\xb0\x84\xe4W-\xc5\x02\x988\xa4\xb0\\\xc1\xbaG\xf9J\xc4\x94\xd7\xaclp\x01kK\xd8\x88OB\xde\x88	\xa9\xf07,\xcbo\xed'\xa0RR\x01\xad\xd2\xb0\x95\n\xb6\x8c\x97\x8dB\x0df\xcf\x0c0\x85 \xa4\x81\x02\xf3\x92),\x80\x0b0{\x04]c>\x0b\x92s)\xb4\x81\x17\xb2\xc0\xd7\xe2\x9a\x95\xbcx\xaevM\x85\xc2\xc0\x12\xa6\xdc\x0d}d~lz\xba\xea\x83`\x8d\xd9\xa30<\xb70-a\xda\xf4\x87F\x16\xbdEUq\xad\xb9\x14/Qp\xb7\xaan\xc7>\x16vpd\xdd\xcf\xd2\xfc \x1ba\xe9\x854\x1f\xb7\xf41B\xf7Z\x18T\x82\x95D\xc7\xfd\xef\xe9d2\x7f\xf2\x04^\xe2\x965\xa5Y\xf3\nec\xa0\xe4\x157\x1arV\x96:#\x80*^\x96\\c.E\xa13\xa8X\x81d\xe8\xbc\xe4(\x88N\xa1\xdd\xe5\x0d7{Z\xce\xc08F3x2\xef\xeb1\x10\xb4\x84\xa7\xe7\xe7\xe7\xe7N\x89\xd7\xe2Z~B\x05\x1aEA\xc6BP\xf8\x8f\x06\xb5\xb1Vd\x02T\x9d\x03\x13\x05(4\x8d\x12\x8eD\xa3\xbaF\xf5\x8d\x06\x85\xba\x96Bc,\xd3\xbaZ`\xbb\x84\xa4B\xb3\x97\xc5\x02\xb4Q\\\xec\xb2\xc0\x7f\x01\xef\x9c \xebUo\x95\xac\xb8\xc6g\xef<\xc3\x95\xd5n\x02O\xc0\x02\x98cm\xa4\x82\x1b\xc5j\x0dx\x8d\xea`qjA!_r\xc0d\xc1\xb5\xa4*P\xc1\x8e_\xa3\xa0\xa17\xb5\xe1R\xe8\x0c\x8c\x04V\x14\xc4y\x8f\xac@\xa53(\xe5n\xc7\xc5\x0e\xa4\x82\n\x8d\xe2\xb9\x9e\xc1k\x03U\xa3\x8d\x13#\xf0\xd6\xd0\xc2\\\n\xc3E\x83\x16t+\x85\xa6g\x93\xd3\xddw:\x7f\x05\x02\x99\x15\xb0\x08\xa0}\x01\x10\xbf\x13Rf\xcbw>\xc6\xc2\xf6g\xb0\xde#l\x98\xc6\x0f\xef~\x02\xae\xe1f\x8f\n#\xa3\xd1X%\x1ba\xb0\xc8@7\xf9\x1e\x98&\xae\xbf\xec\x8d\xa9\xf5b>\xc7[V\xd5%\xdaL\xc2j\xfe\x8b\xe3\xb8+\xe5\x86\x95\xb0E\x93\xef\x89E\xa3\xb1\x80F\x94\xa85H\x814d\xa1\xce\xac\xaf0\x10\xb8c\x86_#\xb1\xf6~	\x05\xd7lS\xa2\x06nz\x90\xd9\xb0\xd8\xb2\x1c\xdb\xad\xddM \xec!\xf8\xcd\x04\x9c\xf0\x8b\x05$_\xe9A\x10$_,@4\xd5\x06\xd5\x04\x9c0g\x1a}\xb1\x88\xbd\xeb\xf2jr\xb4^\x07/XY\x06UXQ\xe8\xe0)\xe4\x02\x0c4\x17\xbb\xd2Y>#\x97a\x1b\xa9\x0cm\xcay\x05\x11\xec\x04+g\xa3;\x8cY\xd3.=\xe7\x0b\x8a\x86\\\xaa\xe2Y\xf0\x11\xf7w5\x01\xcf\xeeb\x01\xcfI\xd0{\xcb\xdckJ\xe8\xbe{\xfb\xe2\x95\xcd\xbd\\S\xb8\xba<\xac\x90\xe4b\x11b\xc3\x05\xec\x0c\x028:J\x1b\nYAv\xf1K]\xf8\x10\xe7\x8d,\x0e\xad\x8f\x80\xd9K\x8d\xb0U\xb2\x82Z\xc9[Nv\x14\xf4-\x0c\xc8m\xe4a\x99\xcd\xf6\xad\x06\x1e\x14:\x19\x88i\x81\x8a_cA\x0b+\xbb\xe8\xc7\xf5\xfa-h\xc3L\xa3{^\x91\x97L\xebvw\xcf^\xc22\x1c=+\xc0[c\xd3\x95\xdb8\xe1H\x9b\x90\xa2<@?\xdb\xc43NF\xe4\n\xed\x1a\xd2-\xf2\xb3v\xbc@\xc3x\xa9\x17\xf0r2\x01\x8a7mT\x93\x1b\xa9N\"\xba\xcf;\xebq\xcc\xa0B\xad\xd9\xae\x15\x91E\x8cS\xab=\x80nj$\xb6\x960\xb5Co6\xbfbnf\x1a\xcd[%\x8d\xa4\xb4\xfaf\x9b\x98=\xd7\x94/nf\x86\xa9\x1d\x9aY\x1d&\xdd*\x9a\x9f	V!\x1d8\x01\xbdi7\xe54\x87\xa5\x07\xaa\x9bp[\x80\xa5\xdfK7A{\x81\xa57`\x18\xf4;\x80e\xd8\xcb\x04\xe0\x18\xe2g\xbdW\xf2F\x9cz%\x99\x9b\x8e\x13_\x05\xe8\xcc9\x07\x9dz\x9e\x0bM\xc9\x02\x8b8x\x9c#D<\xc7}\xa1\xf3\x94\x15\xdc\xfd\xb7\xccE\x10\x06v\x8eM\xbb\xbc\xb5\xf2\x88]\xa2\xcdL#\xe0(>\xd6\x8a	M\xc1\xdb\xc2g,1%r\xd1;\x9fs\xd9\x94\x85\xad\xaa6\x14z\x14\x86\n\x84l\xcfd\xb8q\x99]a\x8e\x14r\x1emb\xe1\xccaC\xd0&UJc4nS\x19e\x00Tj$\x18\x07\xba\xfd\xae(D\xa5\x16]\x0d\xf9\xb0\x99b\xdaa\xa0\x10\xf2p\x06\xd3\x05L\xe1\x0c\xde[9	*\x95\xfe\xdb\x82\xa7\xbf\xdb\xaf	!TT\xf0\xa0R\x03\x9b\xba\xba{\xd4\xa0\x8c\xf2k\x8eZo\x9b\xb2\xb3\\\xcf\xb4>$2ht\xc3\xca\xf2\x00\x1b\xccY\xa3m6\xed\x0e~{\xee\xfa#\xfe\x86\x8e\xfc\xae;\xb0v.\xf8v\x8b\x8a\xbc\x84j\xee\xb1T\x1bk\xf9\xbbL{\x7f\x82\xfd-F\x1f\xa6\xd2\xafs\x02\x0b\x12\xd5p\xe4\x0d>\x95\x9d\xc1\xb4\x85\xf4?\xe7&\x11l\xffR\x9a\x1ds\x9e.\xe2|}N\xe5\xa6\xeb\xaf\xcc>6\xb1o\n2\xb8\xd9\xf3|\x0fzOG0\xd5e5*PR\x1aO1\x92W[	=+KW\xfe,BI\x16\xcf\x0d+\xb3A\x14\x0f\x97\x86\xc8\xb5\xb8\xf8IX\x06	\x1d2\x9em7\x15Ff\n\xeb\x92\xe5\x98\xcc\xff>?\xfb\x9fy\x06\xd3)\x85\xeb\x91\x043}\x10\xb9\x85\xe5\xd9z\x95Xf\x83,b\xc7\x98\xda\xe96\xed\\^\xb9A\xeb3h\xebI\xdb\xda\xd8f\xf8\xf2\xca\x96\xdf\xeb\xcc+F\xb9\xf7\xa48\x0b\x8d\xb4g\xb8Z9\xea\xbc+\xee\x16\xbdJo	wG\"I\x17m\x99\xba^yX,t\xf4_\xa3dY\"e\x10\x817\xae\xd8{\xd1\x8e&iD\xec\xd2\xf4\x12\x12\xabj\xb7tf'z\xa4\xaex\xa4\xe3\xbbSg\xe6\x06-C\xbe\x85\xc4\xd3<~\xec\xa9\x1d\x1b,\x82\xe1\x00b\xbeG\xc0Rc\xb4\xb0#\x0b\xcb\x8b\xe2\xd55\n\xf3\x13\xd7\x86<4\x99\xda\xf5\xd3\xcc\xf1\xf1l&\x91\x96\xbeN\x87e\xcfIf\xed\xf0\x92\xce\xfb\x02\xb7\\`\x01\x17\xc3~v1\xbaj\xc0\x9ep\x0d\xfcVp\x0e\x17\xa01\xb4\xde\x89\xd5+\x0b\xf3),:q\xb1\x9a\xa1\x0dp\x16\xfa\xd1}%1\xb2\x9e\xc2m\xd1\x7f\xd0!\x94L\xc9\x94(\xcc\xb7\xebC\x8d\xd3\x0c\xa6\xac\xaeK\x9e3Z6\xa7\x9b\x98il\xb5p\xc4;A\xbeONzQr\x06\xd39\xe5\xbaP\x83\x04\x1b\x04\xef\x9f\xbe}\xf3~=\xcd\xfc\xa8We\x11~\x84q*\xf1\x17\xf0\xe7\xf7o~\x9e9\xdf\xe6\xdbCB\xc1\x92\x06\ng\xd3E\xecen\xc8\x11\x1c\xd3\x18 JRC#\xba\x02\xe3\xf3gWiX\xe2\x12\xa9\xc8\xa0^\xb7\xedy\xc9\x9b?\x86\xbd\xf8\xed[\xef&\x96\xa1\xe1s\x10\xd1\xbdD\xe2\xe4E\xcd\x1c\xf5 I\xcf\x0f\xe2N\x8f\xe4_^\xa53]\xf2\x1c\x93t\xa6\xe8\x0eAc\x92v\xce\xeb8R#\x0eK\xaf\x9d\x87\xc0}t\x8d|\xdb\xc0[\x05#1\xc3y\xaa\xd3o\xfb\xfeN[\xef\xce\xa4\xd0\x8e\xb5\xa88{\xb4G+\x80Q\x87V\xc3\xb0\x0e\x96\xc0n\x18\x0f\x18\x0e\xa5\xa6\x9e\x9cx\xb5\xa4a\xed\xcc\xe0m\xc8\x10G\xc8\x19\xb5\xf3\xf6<l\xa5\xd8\xea\xc4\xfa]{4\xd8\xc3\xad\x15C\xd4~\xfd\x96\x0b[\x91\x84\xb5\x94\x14(\x88\x14<\x8aC\xb6c\x0e\x90\x97\xc8T\x88;K\x1b\xf4=\xfa\xbfc\x99\xa5\xcd-\n+y\x8d_N/\x81]\x1f\xf6\xa646\xc9\xb7cE8\xbc\xbb\x9at\x04\xf5\xa6$\x9f\xb0QR3r\x1b\x82\xf6!\x0c[\xb6m9\xd8s\x00W~/\x03\xebGK\x10MY\xc2\x85\x1f\xa0R@\xaa^\x12\n\xa9\xfaQkG\xf9\x89\x12\xb6\xe7\xe4\x18t0;_n;\xbavQ\xd4\xda\xc5\x86\x0e\xad\xd3hC\xf3\x83T\xef\xed\xb2\xc4\x8d\xa6\xd9\x90\xdf\x9ab\xe6\xf3\xe7P\x84\xb7dm\x0e=98:|\xee\xf1\x93N\xb7\xa8\xbcj\xd5\x1b\xc8\xcf:\xbc\xbd\xa4I\x0b\x98\x03\xe8\xd1\x10 \xf21\xea	\xe4\xb6\x85p	S\x17w\xd3\x14\xee`>\xa7\xccc\xe8\xe2\xa3.\x19\x17>&5]\xa8\xc8\x92n\x16]\xad\x1d\xc0\x84\xc0\x07\xee|\xf3\x1f_\xfeF=\xa5#\x0b\xbe~\x9c\xf4,\xe6[m\x0f\xa4%\xb5\xedw\xda2\x18\xce\xfa\xe1\x10C\xce\xf0\x0e\x0e\xd7d\xc22\xf4Bm\x11;\xdb3\xfd\xe6F\xbcU\xb2Fe\x0e3:\xc0\x12\x8b\xb8p&O\xe1\xc27)\x97$\xfd\xea\xc4\x15\x1d\xb6=1=C\xb6\xae\xd9\xde\x13\x9cX\xa0\x8d?\xba	(\x16\xbd;\x83\xcf'\xe2\xfa!\xe9\x8b7\xbb\xd2\x9f\x91\xd1\xf2\xfb\xdd\xe4\xa4?\xef\xf4Oz\xea\xfa&2\x0e\xf0\xe09\xf4o>\x0f\xbd\xbd{\xdd(\xa4\xf8\xc6xv\xfd\xcb/f\xef\xe4B|\x01\x17\xda \x0b\x18\x86\x1c\x15|5<\x8e\xc4\xe92\x84i\x98;Yz<\x89\x98\x93h\xfe\x12\x0e\xfd\xad\xc7\x11\xd4?}\xa8H\xf6\xbbL\x06\xa9\xeb\xf1\xe3\x90\xbb\xc23\xc1IR\x0b\x13\x0b:\x88\x1f\xc8\x9d\xbf1\xf4\xbb\xd3(4\xdd\xdbF\xe4TV\x8df\xafp\xfd\x97\x86[\x06+W\xdfp\xab\x88O]\x1e\xff\x9ci\x84\xef\xcf\xcf\x17}\x04F\x1e\xa8b\xf2\xefF\xc8\x07/S1\xf9\xd3\x11\xf2\xe1\x9bTL\xff\xfd\x08}x\x8b\xf2]\x8d-\x8eG\xc8\xc2S\xd4	Px[cn\xa82\xb5I\xb1\xbb\x80\xe9\x9e\x08\xed\xb1iq\x89R'M\xdb\xc0\xa7U\x01\xb5\xce~\xc4\xd0y\xe2\xd4I\xc0\xc2v\xe1DM\xf5k\x06;i\xec\xc8\xa0\x00%\xbe6\x04\x8f\x93v\x034F\xf7\x8b\xa1y\x8d\x12\xdd_\xdd\xf3\xe4\xb0)\x0bo\x9c\xb6J\xb3\xcbG\xd6\xbad\xda-\xf6\xd7\xee\xbd\xb5\x11<\xe1\x88\xc8,\xc3t\x8c\xe3\xcf\xd6\xbf:\x8e\xee\x8e\xf1~\x8en\xfe!\x8e\x7f\x92\xb2D&:\x96\x1b7p?OO0\xce\x14E\x04\xda+q\n\x9a}\xee\xb5<\xed/\xfb\xd2\xdb\x08n\xdc\xa7\xb6\x19.\x1c\x8eX\xd5\xe6\x00\xd2^\xa3\xe81\xe5?\x08nb0\xcar\xd5v\xae\x94 \xc6tsk\x82j\xfd5\xc9\x1d\xb5\x1c\xf3\xb9m\xd6\xe8	B\xf3\x02\xadK\xf5\x15k\x04\xbf\x85\xf6\xf1\x94\xca\xfd\x83{\n\xb3\xdd+\xd3\xf0\xee\x87\x17\xf0\xf4\xe9\xd3\xff\xf7\xce~\x9f\xf2\xb7T\xabv\x1bx\xc9\x0c\xf6q'O\xa7Q\x7f\x1c:\xf3{\xe7y\x02\xdf\x9d\x9f\x9f\xa7\xf7\xec\xd1\xb3~%\x06\xac\x07\xf8\xcfv\xaeQMR\x98[~c\x9a\xfeF-}\x85\xe1\x03\xad\xc7\xcf\xb9\xc7W\xaaf\xe4\xeb\xf7o<3g\x96\x82\x19FOQ\xc1A\xe8\x0e\xe7\x7f\xbf\xcf\xec\xf5\xa4s\x16KQ\xb1C{m\xcd\xf4\x88'84_2\xc3:\xf0?pa\xfe\xef\xb9R\xec\xd07A{\xdd\x0f\x1b.\x98\xa2\xae\x87v\x16\xeab\xb8\x80\xe9\x14\x16\xc0\x8c\xdcx+\xf5\xf7\x1fV[\xcd\\=\xd1\x89J\x1c\xcfY\x89bg\xf6Dl\x1bP\xaa\xe3yq\x0bK8\xff\x83\xfd\xf1\x0cz\x84v\xf0\xec,dEb}\xc9\x8b\xdb+X\x06\xba|\xcf\x14\xa5\xe5\xe7&\xe1\xc5\xed \xdd\x11\xfd\xe48f\x19\x07Ip\x9a\x01$\x9d}\x08\x93\x12#D\xa6t;i\xe7g[\xa9^\xb1|\x9f$\x9b\x83\xe9\xa8!\xd0\x9e\x85\x1asF7\xc7/\xbc\x9a\x8e\x98\xb4L;57F2\x0fPJ\xd9y>\xf7F.\xb96T\xfb\x14P\xd1\x93\xfd\x03\xe6nO!g\x19\xba4\xa2\xab\xbcp9\x17l\xbf^\xa5\x9d#\xac/\xafV\xfe\xbe\xd2\x1e\xc9>\xdc\x96+H\x06\x86\xbf\xa4\xe2\xd5\x0d\xdaZ\x8c\xee\xf7f\x15\xab\x93\x84\x1b\xac\xec\xde}Ec\xbf\xd3tr<Q\x0cE\xac\x98\xfb\xea\xc2\xd6*\xf6J\xdc\xa3\xd80`\xfa\xa2QD\xa2\xa3\xd3m\x80\xc9_X\xfdEH\x067\x94\xeb\xd5=\x00\xf9\xd2\xc6:T\xc5\xea\x93\xabM\xfb\x7f\xfc\xdc\x1d\xdb\x06\xca\x9f\xf1\x83\xea=\xba\x83\xf9\x84\x07\xba{\xf1M\xc6'<h\x9fV\xbc(\xfaW\xb1\xfa\xf2\x13\x1e\xc8\xf9=\xdaDb\x87\x86\xedzTjV\xac\xf6\xa5\xca\xb8E<,\x0f\x1a\xe4\x0eHJ\xa8h\xae\x16\xb0\x8e\xbb\x0b8\xdeg\xad/\xc1\x14\x15\x19\x1e\xac\x87!q~\xd0a\xe2\xef\xaf\x0cV\xb0t\xaeAz^y0\xa8\xc6\xb2s\xf7\xb4\xc7=Dc'\xfa\x1a0O\xd0t&q\x97\xc3\xac\xfc\xa2\xa7\x11\x82\xe4\x0d\xf78X2H\xbdD\n\x8b\xd8\xee\x0f\x04Y\xac\xc4\xc3\x816\xaaDg?\xf7\x13\x96'zx\xb4\xaeY\xd9`\x9aN\x8e\x93\x7f\x0e\x00PK\x07\x08$\xe4t\x96y\x0c\x00\x00`'\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xcfQR]\x9bE[T\x06\x08\x00\x00\xa3%\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00elm/Rpc.elm.gotmplUT\x05\x00\x01\x07\x9c\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xcdQR]\xf3\x95\xa5M\x12\x06\x00\x00\x96\x15\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81O\x08\x00\x00elm/RpcUtil.elm.gotmplUT\x05\x00\x01\x03\x9c\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x1d\\R]\x88V\xc6\x1eJ\x0c\x00\x00\xb8'\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xae\x0e\x00\x00golang/client.go.gotmplUT\x05\x00\x01j\xae\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xecYR]\xb9\x989\x0c\xce\x05\x00\x00\x86\x13\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81F\x1b\x00\x00golang/pkg.go.gotmplUT\x05\x00\x01M\xaa\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x05\\R]\xec\xee\xa0x\xca\x11\x00\x00|=\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81_!\x00\x00golang/server.go.gotmplUT\x05\x00\x01;\xae\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xee\x1a\x82O\xcdsP\xaa\x82\x00\x00\x00\xdd\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81w3\x00\x00golang/shared.go.gotmplUT\x05\x00\x01\xb0\x83\xe4]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#WR]\x10)7\xf5:\x06\x00\x00\"\x13\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81G4\x00\x00kotlin/Rpc.kt.gotmplUT\x05\x00\x01\x02\xa6\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x008WR]\xcf\xf4\xac!\x98\x0d\x00\x00\x8b.\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xcc:\x00\x00kotlin/RpcUtil.kt.gotmplUT\x05\x00\x01,\xa6\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x81UR]\xd7\x8a\x05\xca\x7f\x00\x00\x00\xa7\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xb3H\x00\x00python/__init__.py.gotmplUT\x05\x00\x01\xf3\xa2\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00dUR]\x17g\x13O\x0f	\x00\x00\xb4\x1c\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x82I\x00\x00python/client.py.gotmplUT\x05\x00\x01\xbc\xa2\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xbbUR]<\xb7\xb83\"\n\x00\x00\xea \x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdfR\x00\x00python/rpc.py.gotmplUT\x05\x00\x01b\xa3\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x94UR]qF\xa6\xb8^\x0b\x00\x00\xfa!\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81L]\x00\x00python/server.py.gotmplUT\x05\x00\x01\x18\xa3\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xc3VR]Y\xfd\x1br\xe2\x05\x00\x00W\x13\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf8h\x00\x00swift/Rpc.swift.gotmplUT\x05\x00\x01N\xa5\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00/\\R]\x7f\x8c\xfa\xf77\x12\x00\x00\xf5G\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81'o\x00\x00swift/RpcUtil.swift.gotmplUT\x05\x00\x01\x8a\xae\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00yTR]\xd5,:\x1f\xb3\x04\x00\x00Y\x0f\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xaf\x81\x00\x00ts/rpc.ts.gotmplUT\x05\x00\x01\x07\xa1\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x97TR]$\xe4t\x96y\x0c\x00\x00`'\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa9\x86\x00\x00ts/rpcUtil.ts.gotmplUT\x05\x00\x01>\xa1\xd4jPK\x05\x06\x00\x00\x00\x00\x10\x00\x10\x00\xcc\x04\x00\x00m\x93\x00\x00\x00\x00"
 	fs.Register(data)
 }
@@ -1,12 +1,23 @@
 package tmpldata
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/rakyll/statik/fs"
 )
 
+// TemplateExt is the extension of template files, built-in or in the overlay.
+const TemplateExt = ".gotmpl"
+
 var fileSystem http.FileSystem
 
 func init() {
@@ -29,3 +40,93 @@ func Read(path string) (string, error) {
 		return string(bytes), nil
 	}
 }
+
+// Overlay is a folder of templates preferred over the built-in ones at the same path or
+// with the same base name, such as `golang/server.go.gotmpl` or `server.go.gotmpl` for
+// `/golang/server.go.gotmpl`, so individual templates can be replaced. The zero Overlay
+// replaces nothing.
+type Overlay struct {
+	Dir string
+}
+
+// NewOverlay returns the Overlay of dir, which must be an existing folder.
+func NewOverlay(dir string) (Overlay, error) {
+	if info, err := os.Stat(dir); err != nil {
+		return Overlay{}, fmt.Errorf("templates folder: %w", err)
+	} else if !info.IsDir() {
+		return Overlay{}, errors.New("templates folder `" + dir + "` is not a folder")
+	}
+	return Overlay{Dir: dir}, nil
+}
+
+// Read reads the built-in template at path, or the one replacing it in the overlay.
+func (o Overlay) Read(path string) (string, error) {
+	if overlaid, ok := o.lookup(path); ok {
+		bytes, err := ioutil.ReadFile(overlaid)
+		return string(bytes), err
+	}
+	return Read(path)
+}
+
+// Extras returns the templates in the overlay folder mirroring dir, such as `golang/` for
+// `/golang`, that don't replace one of the built-in templates in dir. Templates elsewhere
+// in the overlay are left to the generators they replace. Names are slash-separated paths
+// relative to that folder.
+func (o Overlay) Extras(dir string) ([]string, error) {
+	if o.Dir == "" {
+		return nil, nil
+	}
+
+	root := o.path(dir)
+	if info, err := os.Stat(root); err != nil || !info.IsDir() {
+		return nil, nil
+	}
+
+	var names []string
+	err := filepath.Walk(root, func(filename string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(filename, TemplateExt) {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, filename)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if _, err := fs.ReadFile(fileSystem, path.Join(dir, rel)); err == nil {
+			return nil // replaces a built-in template
+		}
+
+		names = append(names, rel)
+		return nil
+	})
+
+	sort.Strings(names)
+	return names, err
+}
+
+// ReadExtra reads one of the templates returned by Extras for the same dir.
+func (o Overlay) ReadExtra(dir, name string) (string, error) {
+	bytes, err := ioutil.ReadFile(filepath.Join(o.path(dir), filepath.FromSlash(name)))
+	return string(bytes), err
+}
+
+// lookup finds the template replacing name in the overlay, at the same path or else by
+// its base name at the top of the overlay.
+func (o Overlay) lookup(name string) (string, bool) {
+	if o.Dir == "" {
+		return "", false
+	}
+
+	for _, filename := range []string{o.path(name), filepath.Join(o.Dir, path.Base(name))} {
+		if info, err := os.Stat(filename); err == nil && !info.IsDir() {
+			return filename, true
+		}
+	}
+	return "", false
+}
+
+// path maps a slash-separated path of the built-in templates to the overlay.
+func (o Overlay) path(name string) string {
+	return filepath.Join(o.Dir, filepath.FromSlash(strings.TrimPrefix(name, "/")))
+}
@@ -0,0 +1,52 @@
+package ts
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/chakrit/rpc/spec"
+)
+
+func funcMap() template.FuncMap {
+	f := template.FuncMap{}
+	f["quote"] = strconv.Quote
+	f["tsdoc"] = tsdoc
+	f["comment"] = comment
+	return f
+}
+
+// tsdoc renders a doc comment from the spec as a `/** */` block, terminated by a newline
+// so it can be placed right before a top-level declaration. A `@deprecated` annotation
+// adds the tag that editors pick up.
+func tsdoc(doc string, annotations ...spec.Annotations) string {
+	return comment("", doc, annotations...)
+}
+
+// comment renders a doc comment the same way as tsdoc, for declarations nested at the
+// given indentation such as properties and methods.
+func comment(indent, doc string, annotations ...spec.Annotations) string {
+	for _, list := range annotations {
+		if deprecated := list.Lookup("deprecated"); deprecated != nil {
+			if doc != "" {
+				doc += "\n\n"
+			}
+			doc += "@deprecated " + deprecated.Arg(0)
+		}
+	}
+
+	doc = strings.TrimSpace(strings.ReplaceAll(doc, "*/", "*\\/"))
+	if doc == "" {
+		return ""
+	} else if !strings.Contains(doc, "\n") {
+		return "/** " + doc + " */\n" + indent
+	}
+
+	sb := &strings.Builder{}
+	sb.WriteString("/**\n")
+	for _, line := range strings.Split(doc, "\n") {
+		sb.WriteString(indent + strings.TrimRight(" * "+line, " ") + "\n")
+	}
+	sb.WriteString(indent + " */\n" + indent)
+	return sb.String()
+}
@@ -0,0 +1,365 @@
+package ts
+
+import (
+	"strconv"
+	"strings"
+
+	gen "github.com/chakrit/rpc/generator/internal"
+	"github.com/chakrit/rpc/internal"
+	"github.com/chakrit/rpc/spec"
+)
+
+// names that can't be used as-is for generated arguments, either reserved words or names
+// already used by the templates.
+var reservedNames = map[string]struct{}{
+	"break": {}, "case": {}, "catch": {}, "class": {}, "const": {}, "continue": {},
+	"debugger": {}, "default": {}, "delete": {}, "do": {}, "else": {}, "enum": {},
+	"export": {}, "extends": {}, "false": {}, "finally": {}, "for": {}, "function": {},
+	"if": {}, "import": {}, "in": {}, "instanceof": {}, "new": {}, "null": {},
+	"return": {}, "super": {}, "switch": {}, "this": {}, "throw": {}, "true": {},
+	"try": {}, "typeof": {}, "var": {}, "void": {}, "while": {}, "with": {}, "yield": {},
+	"let": {}, "static": {}, "implements": {}, "interface": {}, "package": {},
+	"private": {}, "protected": {}, "public": {}, "await": {}, "arguments": {},
+	"eval": {}, "undefined": {},
+
+	"options": {}, "returns": {},
+}
+
+// Module is a namespace, rendered as a nested `export namespace` block with a client
+// class for its rpcs.
+type Module struct {
+	Name       string
+	ClientName string
+	RPCPath    string
+	Namespace  *spec.Namespace
+
+	Types    []*Type
+	Errors   []*Type
+	Enums    []*Enum
+	RPCFuncs []*RpcFunc
+
+	Parent   *Module
+	Children []*Module
+
+	scope    gen.Scope
+	resolver *resolver
+}
+
+// resolver knows the qualified name of every declaration, as seen from the top of the
+// file, such as `todos.Item`.
+type resolver struct {
+	names    map[spec.Node]string
+	rpcPaths map[*spec.Namespace]string
+}
+
+func newRootModule(ns *spec.Namespace) *Module {
+	r := &resolver{
+		names:    map[spec.Node]string{},
+		rpcPaths: gen.RPCPaths(ns),
+	}
+	r.register(ns, "")
+	return newModule(nil, ns, r)
+}
+
+func (r *resolver) register(ns *spec.Namespace, prefix string) {
+	for _, node := range ns.Types {
+		r.names[node] = prefix + node.(*spec.Type).Name
+	}
+	for _, node := range ns.Enums {
+		r.names[node] = prefix + node.(*spec.Enum).Name
+	}
+	for _, node := range ns.Errors {
+		r.names[node] = prefix + node.(*spec.Error).Name
+	}
+	for _, node := range ns.Children {
+		child := node.(*spec.Namespace)
+		r.register(child, prefix+child.Name+".")
+	}
+}
+
+func newModule(parent *Module, ns *spec.Namespace, r *resolver) *Module {
+	mod := &Module{
+		Name:       ns.Name,
+		ClientName: "Client",
+		RPCPath:    r.rpcPaths[ns],
+		Namespace:  ns,
+		Parent:     parent,
+		resolver:   r,
+	}
+	if parent == nil {
+		mod.Name = ""
+		mod.scope = gen.Scope{ns}
+	} else {
+		mod.scope = append(append(gen.Scope{}, parent.scope...), ns)
+		if parent.Parent == nil {
+			mod.ClientName = "Client_" + internal.InflectSnake(ns.Name)
+		} else {
+			mod.ClientName = parent.ClientName + "_" + internal.InflectSnake(ns.Name)
+		}
+	}
+
+	mod.resolveTypes()
+	mod.resolveRPCFuncs()
+	for _, node := range ns.Children.SortedByName() {
+		mod.Children = append(mod.Children, newModule(mod, node.(*spec.Namespace), r))
+	}
+	return mod
+}
+
+// flatten returns the module followed by all of its descendants.
+func (m *Module) flatten() []*Module {
+	modules := []*Module{m}
+	for _, child := range m.Children {
+		modules = append(modules, child.flatten()...)
+	}
+	return modules
+}
+
+// Property returns the name of the client property that holds the child's client.
+func (m *Module) Property() string {
+	return internal.InflectCamel(m.Name)
+}
+
+// ClientDoc returns the doc comment of the module's client class.
+func (m *Module) ClientDoc() string {
+	if m.Parent != nil {
+		return m.ClientName + " calls the rpcs of the `" + m.Name + "` namespace."
+	}
+
+	doc := "Client calls the rpcs of the spec, and those of nested namespaces through its\n" +
+		"properties. Calls fail with an RpcUtil.ThrownError for the errors an rpc declares,\n" +
+		"told apart with the generated `is` functions, an RpcUtil.RPCError for other errors\n" +
+		"reported by the server, or an RpcUtil.TransportError or RpcUtil.DecodeError when the\n" +
+		"call itself fails."
+	if m.Namespace.Doc != "" {
+		doc = m.Namespace.Doc + "\n\n" + doc
+	}
+	return doc
+}
+
+func (m *Module) resolveTypes() {
+	for _, node := range m.Namespace.Types.SortedByName() {
+		typ := node.(*spec.Type)
+		m.Types = append(m.Types, m.newType(typ.Name, typ.Doc, typ.Properties, typ.Annotations))
+	}
+
+	for _, node := range m.Namespace.Errors.SortedByName() {
+		err := node.(*spec.Error)
+		tsType := m.newType(err.Name, err.Doc, err.Properties, err.Annotations)
+		tsType.Code = err.Code()
+		m.Errors = append(m.Errors, tsType)
+	}
+
+	for _, node := range m.Namespace.Enums.SortedByName() {
+		enum := node.(*spec.Enum)
+		tsEnum := &Enum{
+			Name: enum.Name,
+			Doc:  enum.Doc,
+
+			Annotations: enum.Annotations,
+		}
+
+		for _, member := range enum.Members {
+			literal := enum.Value(member)
+			if !enum.Integer {
+				literal = strconv.Quote(literal)
+			}
+
+			tsEnum.Members = append(tsEnum.Members, &Member{
+				Name:    member,
+				Doc:     enum.MemberDocs[member],
+				Literal: literal,
+			})
+		}
+		m.Enums = append(m.Enums, tsEnum)
+	}
+}
+
+func (m *Module) newType(name, doc string, props spec.Mappings, annotations spec.Annotations) *Type {
+	tsType := &Type{
+		Name: name,
+		Doc:  doc,
+
+		Annotations: annotations,
+	}
+
+	for _, node := range props.SortedByName() {
+		prop := node.(*spec.Property)
+		jsonName := prop.Name
+		if name := prop.Annotations.Lookup("json").Param("name"); name != "" {
+			jsonName = name
+		}
+
+		tsType.Fields = append(tsType.Fields, &Field{
+			Name:     prop.Name,
+			JSONName: jsonName,
+			Doc:      prop.Doc,
+			Type:     m.resolveProperty(prop.Type),
+
+			Annotations: prop.Annotations,
+		})
+	}
+	return tsType
+}
+
+func (m *Module) resolveRPCFuncs() {
+	// methods share the client class with the transport and the clients of children
+	taken := map[string]struct{}{"constructor": {}, "transport": {}}
+	for _, node := range m.Namespace.Children {
+		taken[internal.InflectCamel(node.(*spec.Namespace).Name)] = struct{}{}
+	}
+
+	for _, node := range m.Namespace.RPCs.SortedByName() {
+		rpc := node.(*spec.RPC)
+		name := internal.InflectCamel(rpc.Name)
+		if _, isTaken := taken[name]; isTaken {
+			name += "_"
+		}
+
+		fn := &RpcFunc{
+			Name:    name,
+			Doc:     rpc.Doc,
+			RPCPath: m.RPCPath + "/" + rpc.Name,
+
+			Annotations: rpc.Annotations,
+		}
+
+		for idx, ref := range rpc.InputTypes {
+			fn.Args = append(fn.Args, &Arg{Name: argName(rpc, idx), Type: m.resolve(ref)})
+		}
+		for _, ref := range rpc.OutputTypes {
+			fn.Returns = append(fn.Returns, m.resolve(ref))
+		}
+		for _, ref := range rpc.Errors {
+			if node := m.lookupError(ref.Name); node != nil {
+				fn.Errors = append(fn.Errors, &Thrown{
+					Code:   node.Code(),
+					Decode: m.qualify(node, "decode"),
+				})
+			}
+		}
+
+		m.RPCFuncs = append(m.RPCFuncs, fn)
+	}
+}
+
+// argName returns a TypeScript parameter name for the rpc input argument at the given
+// index, falling back to positional names for unnamed arguments.
+func argName(rpc *spec.RPC, index int) string {
+	if index >= len(rpc.InputNames) || rpc.InputNames[index] == "" {
+		return "arg" + strconv.Itoa(index)
+	}
+
+	name := internal.InflectCamel(rpc.InputNames[index])
+	if _, reserved := reservedNames[name]; reserved {
+		name += "_"
+	}
+	return name
+}
+
+func (m *Module) lookupError(name string) *spec.Error {
+	for idx := len(m.scope) - 1; idx >= 0; idx-- {
+		if node, ok := m.scope[idx].Errors[name]; ok {
+			return node.(*spec.Error)
+		}
+	}
+	return nil
+}
+
+// qualify returns the name of the declaration, or one of its functions when given a
+// prefix such as "decode", as seen from the top of the file.
+func (m *Module) qualify(node spec.Node, prefix string) string {
+	name := m.resolver.names[node]
+	if prefix == "" {
+		return name
+	}
+
+	idx := strings.LastIndex(name, ".") + 1
+	return name[:idx] + prefix + name[idx:]
+}
+
+// resolveProperty resolves the type of a property. Like the generated Go code, only
+// `time` properties, optional or not, are sent as unix seconds.
+func (m *Module) resolveProperty(ref *spec.TypeRef) *Resolution {
+	unixTime := &Resolution{
+		Name:   "Date",
+		Encode: "RpcUtil.encodeUnixTime",
+		Decode: "RpcUtil.decodeUnixTime",
+	}
+
+	switch {
+	case ref.Name == "time":
+		return unixTime
+	case ref.Name == "optional" && len(ref.Arguments) > 0 && ref.Arguments[0].Name == "time":
+		return optional(unixTime)
+	default:
+		return m.resolve(ref)
+	}
+}
+
+// resolve resolves the type of anything sent as-is by encoding/json in the generated Go
+// code, such as rpc arguments, return values and the contents of lists and maps.
+func (m *Module) resolve(ref *spec.TypeRef) *Resolution {
+	switch ref.Name {
+	case "unit":
+		return &Resolution{Name: "null", Encode: "RpcUtil.encodeUnit", Decode: "RpcUtil.decodeUnit"}
+	case "string":
+		return &Resolution{Name: "string", Encode: "RpcUtil.encodeValue", Decode: "RpcUtil.decodeString"}
+	case "bool":
+		return &Resolution{Name: "boolean", Encode: "RpcUtil.encodeValue", Decode: "RpcUtil.decodeBoolean"}
+	case "int", "long", "float", "double":
+		return &Resolution{Name: "number", Encode: "RpcUtil.encodeValue", Decode: "RpcUtil.decodeNumber"}
+	case "time":
+		return &Resolution{Name: "Date", Encode: "RpcUtil.encodeTime", Decode: "RpcUtil.decodeTime"}
+	case "data":
+		return &Resolution{Name: "Uint8Array", Encode: "RpcUtil.encodeData", Decode: "RpcUtil.decodeData"}
+	case "list":
+		element := m.resolveArg(ref, 0)
+		return &Resolution{
+			Name:   "Array<" + element.Name + ">",
+			Encode: "RpcUtil.encodeList(" + element.Encode + ")",
+			Decode: "RpcUtil.decodeList(" + element.Decode + ")",
+		}
+	case "map":
+		key, value := m.resolveArg(ref, 0), m.resolveArg(ref, 1)
+		name := "Record<string, " + value.Name + ">"
+		if key.Name != "string" { // enum keys, not every member has to be present
+			name = "Partial<Record<" + key.Name + ", " + value.Name + ">>"
+		}
+		return &Resolution{
+			Name:   name,
+			Encode: "RpcUtil.encodeMap(" + value.Encode + ")",
+			Decode: "RpcUtil.decodeMap(" + value.Decode + ")",
+		}
+	case "optional":
+		return optional(m.resolveArg(ref, 0))
+	}
+
+	if node := m.scope.Lookup(ref.Name); node != nil {
+		return &Resolution{
+			Name:   m.qualify(node, ""),
+			Encode: m.qualify(node, "encode"),
+			Decode: m.qualify(node, "decode"),
+		}
+	}
+	return resolveUnknown() // unknown types are rejected by the validator
+}
+
+func (m *Module) resolveArg(ref *spec.TypeRef, index int) *Resolution {
+	if index >= len(ref.Arguments) {
+		return resolveUnknown()
+	}
+	return m.resolve(ref.Arguments[index])
+}
+
+func optional(inner *Resolution) *Resolution {
+	return &Resolution{
+		Name:   inner.Name + " | null",
+		Encode: "RpcUtil.encodeOptional(" + inner.Encode + ")",
+		Decode: "RpcUtil.decodeOptional(" + inner.Decode + ")",
+	}
+}
+
+func resolveUnknown() *Resolution {
+	return &Resolution{Name: "unknown", Encode: "RpcUtil.encodeValue", Decode: "RpcUtil.decodeValue"}
+}
@@ -0,0 +1,174 @@
+package ts
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/chakrit/rpc/generator/tmpldata"
+	"github.com/chakrit/rpc/spec"
+)
+
+const (
+	RpcTemplateName  = "/ts/rpc.ts.gotmpl"
+	UtilTemplateName = "/ts/rpcUtil.ts.gotmpl"
+	RpcOutName       = "rpc.ts"
+	UtilOutName      = "rpcUtil.ts"
+)
+
+type (
+	// Resolution is how a type reference is written in TypeScript, along with the
+	// functions that convert it to and from its JSON form.
+	Resolution struct {
+		Name   string
+		Encode string
+		Decode string
+	}
+
+	Field struct {
+		Name     string
+		JSONName string
+		Doc      string
+		Type     *Resolution
+
+		Annotations spec.Annotations
+	}
+
+	// Type is a type declaration, or the details of an error declaration.
+	Type struct {
+		Name   string
+		Doc    string
+		Code   string
+		Fields []*Field
+
+		Annotations spec.Annotations
+	}
+
+	Member struct {
+		Name    string
+		Doc     string
+		Literal string
+	}
+
+	Enum struct {
+		Name    string
+		Doc     string
+		Members []*Member
+
+		Annotations spec.Annotations
+	}
+
+	Arg struct {
+		Name string
+		Type *Resolution
+	}
+
+	// Thrown is an error an rpc declares with `throws`, decoded by its details decoder.
+	Thrown struct {
+		Code   string
+		Decode string
+	}
+
+	RpcFunc struct {
+		Name    string
+		Doc     string
+		RPCPath string
+
+		Args    []*Arg
+		Returns []*Resolution
+		Errors  []*Thrown
+
+		Annotations spec.Annotations
+	}
+
+	// File is the data for the rpc.ts template. Declarations holds the nested namespaces
+	// already rendered, while clients are rendered from the flattened list of modules.
+	File struct {
+		Root         *Module
+		Declarations string
+		Modules      []*Module
+	}
+)
+
+func Generate(ns *spec.Namespace, outdir string, templates tmpldata.Overlay) error {
+	root := newRootModule(ns)
+
+	tmpl, err := parse(templates, RpcTemplateName)
+	if err != nil {
+		return fmt.Errorf("ts template failure: %w", err)
+	}
+	declarations, err := renderDeclarations(tmpl, root)
+	if err != nil {
+		return fmt.Errorf("ts template failure: %w", err)
+	}
+
+	file := &File{Root: root, Declarations: declarations, Modules: root.flatten()}
+	if err := write(filepath.Join(outdir, RpcOutName), tmpl, file); err != nil {
+		return fmt.Errorf("ts template failure: %w", err)
+	}
+
+	utilTmpl, err := parse(templates, UtilTemplateName)
+	if err != nil {
+		return fmt.Errorf("ts template failure: %w", err)
+	}
+	if err := write(filepath.Join(outdir, UtilOutName), utilTmpl, file); err != nil {
+		return fmt.Errorf("ts template failure: %w", err)
+	}
+	return nil
+}
+
+func parse(templates tmpldata.Overlay, tmplname string) (*template.Template, error) {
+	tmplContent, err := templates.Read(tmplname)
+	if err != nil {
+		return nil, err
+	}
+	return template.New(tmplname).Funcs(funcMap()).Parse(tmplContent)
+}
+
+// renderDeclarations renders the types of the module followed by its children, each
+// wrapped in an indented `export namespace` block so names resolve the same way as in
+// the spec, innermost namespace first.
+func renderDeclarations(tmpl *template.Template, mod *Module) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.ExecuteTemplate(buf, "declarations", mod); err != nil {
+		return "", err
+	}
+
+	for _, child := range mod.Children {
+		body, err := renderDeclarations(tmpl, child)
+		if err != nil {
+			return "", err
+		}
+
+		buf.WriteString("\n" + tsdoc(child.Namespace.Doc))
+		buf.WriteString("export namespace " + child.Name + " {\n")
+		for _, line := range strings.Split(strings.TrimSpace(body), "\n") {
+			if line != "" {
+				buf.WriteString("  " + line)
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.String(), nil
+}
+
+var extraBlankLines = regexp.MustCompile(`\n{3,}`)
+
+func write(outpath string, tmpl *template.Template, data interface{}) error {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outpath), 0755); err != nil {
+		return err
+	}
+	content := extraBlankLines.ReplaceAll(buf.Bytes(), []byte("\n\n"))
+	return ioutil.WriteFile(outpath, content, 0644)
+}
@@ -1,6 +1,9 @@
 package internal
 
 import (
+	"strings"
+	"unicode"
+
 	"github.com/gobuffalo/flect"
 )
 
@@ -9,6 +12,24 @@ import (
 // Example: go_lang -> GoLanguage
 func InflectPascal(s string) string { return flect.Pascalize(s) }
 
+// InflectCamel takes a word and produces a camel-cased version of it (lowercased first
+// letter, no separators). Unlike flect, acronyms are left as-is.
+//
+// Example: user_id -> userId
+func InflectCamel(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' })
+	for idx, word := range words {
+		runes := []rune(word)
+		if idx == 0 {
+			runes[0] = unicode.ToLower(runes[0])
+		} else {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		words[idx] = string(runes)
+	}
+	return strings.Join(words, "")
+}
+
 // InflectSnake takes a word and produces a snake-cased version of it (separate words with `_`)
 //
 // Example: GoLanguage -> go_language
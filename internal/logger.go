@@ -8,6 +8,8 @@ import (
 type Logger interface {
 	Warn(string)
 	Warnp(Pos, string)
+	Error(error)
+	Errorp(Pos, error)
 	Fatal(error)
 	Fatalp(Pos, error)
 }
@@ -24,6 +26,8 @@ type logger struct{}
 
 func (logger) Warn(msg string)         { log("[warn] ", msg) }
 func (logger) Warnp(p Pos, msg string) { log("[warn] ", p, msg) }
+func (logger) Error(err error)         { log("[error]", err) }
+func (logger) Errorp(p Pos, err error) { log("[error]", p, err) }
 func (logger) Fatal(err error)         { log("[error]", err); os.Exit(1) }
 func (logger) Fatalp(p Pos, err error) { log("[error]", p, err); os.Exit(1) }
 
@@ -31,6 +35,8 @@ type silentLogger struct{}
 
 func (silentLogger) Warn(msg string)         { /* no-op */ }
 func (silentLogger) Warnp(p Pos, msg string) { /* no-op */ }
+func (silentLogger) Error(err error)         { log("[error]", err) }
+func (silentLogger) Errorp(p Pos, err error) { log("[error]", p, err) }
 func (silentLogger) Fatal(err error)         { log("[error]", err); os.Exit(1) }
 func (silentLogger) Fatalp(p Pos, err error) { log("[error]", p, err); os.Exit(1) }
 
@@ -3,11 +3,16 @@ package internal
 import "fmt"
 
 type Pos struct {
-	Byte int `json:"byte_no"`
-	Line int `json:"line_no"`
-	Col  int `json:"col_no"`
+	File string `json:"file,omitempty"`
+	Byte int    `json:"byte_no"`
+	Line int    `json:"line_no"`
+	Col  int    `json:"col_no"`
 }
 
 func (p Pos) String() string {
-	return fmt.Sprintf("line %d col %d", p.Line, p.Col)
+	if p.File != "" {
+		return fmt.Sprintf("%s: line %d col %d", p.File, p.Line, p.Col)
+	} else {
+		return fmt.Sprintf("line %d col %d", p.Line, p.Col)
+	}
 }
@@ -20,6 +20,8 @@ func IsStringEscapeMarker(r rune) bool { return r == '\\' }
 func IsDigit(r rune) bool            { return unicode.IsDigit(r) }
 func IsDecimalSeparator(r rune) bool { return r == '.' }
 func IsArgSeparator(r rune) bool     { return r == ',' }
+func IsAnnotationMarker(r rune) bool { return r == '@' }
+func IsAssign(r rune) bool           { return r == '=' }
 
 func IsValidIdentFirstChar(r rune) bool { return unicode.IsLetter(r) || r == '_' }
 func IsValidIdent(r rune) bool {
@@ -10,23 +10,41 @@ var Keywords = map[string]struct{}{
 	"namespace": {},
 	"type":      {},
 	"enum":      {},
+	"error":     {},
 	"rpc":       {},
+	"throws":    {},
 
-	// built-in types
-	"unit":   {},
-	"string": {},
-	"bool":   {},
-	"int":    {},
-	"long":   {},
-	"float":  {},
-	"double": {},
-	"list":   {},
-	"map":    {},
-	"time":   {},
-	"data":   {},
+	// built-in types are added from BuiltinTypes
+}
+
+// BuiltinTypes maps each built-in type to the number of type arguments it expects.
+var BuiltinTypes = map[string]int{
+	"unit":     0,
+	"string":   0,
+	"bool":     0,
+	"int":      0,
+	"long":     0,
+	"float":    0,
+	"double":   0,
+	"time":     0,
+	"data":     0,
+	"list":     1,
+	"map":      2,
+	"optional": 1,
+}
+
+func init() {
+	for name := range BuiltinTypes {
+		Keywords[name] = struct{}{}
+	}
 }
 
 func IsKeyword(word string) bool {
 	_, ok := Keywords[word]
 	return ok
 }
+
+func IsBuiltinType(word string) bool {
+	_, ok := BuiltinTypes[word]
+	return ok
+}
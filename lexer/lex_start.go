@@ -1,6 +1,7 @@
 package lexer
 
 func lexStart(c *lexer, r rune) lexFunc {
+	c.MarkStart()
 	switch {
 	case IsEOF(r):
 		return nil
@@ -8,6 +9,10 @@ func lexStart(c *lexer, r rune) lexFunc {
 		return lexBrace
 	case IsArgSeparator(r):
 		return lexSeparator
+	case IsAnnotationMarker(r):
+		return lexAnnotationMarker
+	case IsAssign(r):
+		return lexAssign
 	case IsCommentMarker(r):
 		return lexComment
 	case IsStringMarker(r):
@@ -49,3 +54,23 @@ func lexSeparator(c *lexer, r rune) lexFunc {
 	c.Emit(T_ArgListSep, string(r))
 	return lexStart
 }
+
+func lexAnnotationMarker(c *lexer, r rune) lexFunc {
+	c.Precond(IsAnnotationMarker(r), "expecting annotation marker char")
+	if !c.Consume() {
+		return nil
+	}
+
+	c.Emit(T_AnnotationMarker, string(r))
+	return lexStart
+}
+
+func lexAssign(c *lexer, r rune) lexFunc {
+	c.Precond(IsAssign(r), "expecting assignment char")
+	if !c.Consume() {
+		return nil
+	}
+
+	c.Emit(T_Assign, string(r))
+	return lexStart
+}
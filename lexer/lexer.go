@@ -14,6 +14,7 @@ type lexFunc func(*lexer, rune) lexFunc
 
 type Options struct {
 	Input       io.Reader
+	Filename    string
 	Logger      internal.Logger
 	IgnoreTypes TokenType
 }
@@ -22,7 +23,8 @@ type lexer struct {
 	logger  internal.Logger
 	reader  *bufio.Reader
 	ignores TokenType
-	pos     internal.Pos
+	pos     internal.Pos // of the head rune, lines and columns count from 1
+	start   internal.Pos // of the first rune of the token being lexed
 
 	buffer string
 	head   rune
@@ -54,6 +56,7 @@ func newLexer(opts Options, startState lexFunc) (*lexer, error) {
 		logger:  opts.Logger,
 		reader:  bufio.NewReader(opts.Input),
 		ignores: opts.IgnoreTypes,
+		pos:     internal.Pos{File: opts.Filename, Line: 1, Col: 1},
 		state:   startState,
 	}
 
@@ -84,9 +87,10 @@ func (c *lexer) Fail(msg string) lexFunc {
 // buffer handling
 func (c *lexer) MarkNewLine() {
 	c.pos = internal.Pos{
+		File: c.pos.File,
 		Byte: c.pos.Byte,
 		Line: c.pos.Line + 1,
-		Col:  0,
+		Col:  1,
 	}
 }
 
@@ -106,6 +110,7 @@ func (c *lexer) Consume() bool {
 	}
 
 	c.pos = internal.Pos{
+		File: c.pos.File,
 		Byte: c.pos.Byte + n,
 		Col:  c.pos.Col + 1,
 		Line: c.pos.Line,
@@ -124,6 +129,9 @@ func (c *lexer) Step() bool {
 	return c.state != nil && c.err == nil
 }
 
+// MarkStart marks the head rune as the start of the next token.
+func (c *lexer) MarkStart() { c.start = c.pos }
+
 func (c *lexer) Emit(typ TokenType, value string) {
 	if c.ignores.Match(typ) {
 		return
@@ -132,6 +140,6 @@ func (c *lexer) Emit(typ TokenType, value string) {
 	c.tokens = append(c.tokens, &Token{
 		Type:  typ,
 		Value: value,
-		Pos:   c.pos,
+		Pos:   c.start,
 	})
 }
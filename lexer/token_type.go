@@ -19,6 +19,8 @@ const (
 	T_Keyword
 	T_StringValue
 	T_NumberValue
+	T_AnnotationMarker
+	T_Assign
 )
 
 var typeNames = map[TokenType]string{
@@ -38,6 +40,8 @@ var typeNames = map[TokenType]string{
 	T_Keyword:          "keyword",
 	T_StringValue:      "value-string",
 	T_NumberValue:      "value-number",
+	T_AnnotationMarker: "annotation-marker",
+	T_Assign:           "assign",
 }
 
 var braceMappings = map[rune]TokenType{
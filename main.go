@@ -13,6 +13,7 @@ import (
 	"github.com/chakrit/rpc/lexer"
 	"github.com/chakrit/rpc/parser"
 	"github.com/chakrit/rpc/spec"
+	"github.com/chakrit/rpc/validator"
 )
 
 func main() {
@@ -34,7 +35,7 @@ func main() {
 
 func lexMain(opts Options, logger internal.Logger) {
 	var allTokens []*lexer.Token
-	process(opts.SpecFilenames, logger, func(reader io.Reader) error {
+	process(opts.SpecFilenames, logger, func(_ string, reader io.Reader) error {
 		tokens, err := lexer.Lex(lexer.Options{
 			Input:  reader,
 			Logger: logger,
@@ -58,10 +59,11 @@ func lexMain(opts Options, logger internal.Logger) {
 
 func parseMain(opts Options, logger internal.Logger) {
 	root := &spec.Namespace{}
-	process(opts.SpecFilenames, logger, func(reader io.Reader) error {
+	process(opts.SpecFilenames, logger, func(filename string, reader io.Reader) error {
 		if ns, err := parser.Parse(parser.Options{
-			Input:  reader,
-			Logger: logger,
+			Input:    reader,
+			Filename: filename,
+			Logger:   logger,
 		}); err != nil {
 			return err
 		} else {
@@ -70,6 +72,8 @@ func parseMain(opts Options, logger internal.Logger) {
 		}
 	})
 
+	validate(root, logger)
+
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
 	if err := encoder.Encode(root); err != nil {
@@ -79,10 +83,11 @@ func parseMain(opts Options, logger internal.Logger) {
 
 func genMain(opts Options, logger internal.Logger) {
 	root := &spec.Namespace{}
-	process(opts.SpecFilenames, logger, func(reader io.Reader) error {
+	process(opts.SpecFilenames, logger, func(filename string, reader io.Reader) error {
 		if ns, err := parser.Parse(parser.Options{
-			Input:  reader,
-			Logger: logger,
+			Input:    reader,
+			Filename: filename,
+			Logger:   logger,
 		}); err != nil {
 			return err
 		} else {
@@ -91,17 +96,32 @@ func genMain(opts Options, logger internal.Logger) {
 		}
 	})
 
+	validate(root, logger)
+
 	err := generator.Generate(root, &generator.Options{
-		Logger: logger,
-		OutDir: opts.OutputDir,
-		Target: opts.Target,
+		Logger:    logger,
+		OutDir:    opts.OutputDir,
+		Target:    opts.Target,
+		Templates: opts.TemplatesDir,
 	})
 	if err != nil {
 		logger.Fatal(err)
 	}
 }
 
-func process(patterns []string, logger internal.Logger, action func(io.Reader) error) {
+func validate(root *spec.Namespace, logger internal.Logger) {
+	errs := validator.Validate(root)
+	if len(errs) == 0 {
+		return
+	}
+
+	for _, err := range errs {
+		logger.Error(err)
+	}
+	logger.Fatal(fmt.Errorf("%d validation error(s)", len(errs)))
+}
+
+func process(patterns []string, logger internal.Logger, action func(string, io.Reader) error) {
 	processOne := func(filename string) error { // provide scope for defer file closing
 		file, err := openInput(filename)
 		if err != nil {
@@ -109,7 +129,7 @@ func process(patterns []string, logger internal.Logger, action func(io.Reader) e
 		}
 		defer file.Close()
 
-		return action(file)
+		return action(filename, file)
 	}
 
 	sort.Sort(sort.StringSlice(patterns))
@@ -124,9 +144,10 @@ func process(patterns []string, logger internal.Logger, action func(io.Reader) e
 		for _, filename := range filenames {
 			// fatal here, since we allow overrides from chaining multiple RPCs,
 			// skipping one or more input file will have unintended side effects
-			// so failing fast is the better option
+			// so failing fast is the better option. errors are already positioned
+			// in the file they come from.
 			if err := processOne(filename); err != nil {
-				logger.Fatal(fmt.Errorf("%s: %w", filename, err))
+				logger.Fatal(err)
 				return
 			}
 		}
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"errors"
+
+	"github.com/chakrit/rpc/generator"
 )
 
 type Options struct {
@@ -14,6 +16,7 @@ type Options struct {
 	Target    string
 
 	OutputDir     string
+	TemplatesDir  string
 	SpecFilenames []string
 }
 
@@ -30,9 +33,11 @@ func parseOptions() Options {
 	flag.BoolVar(&options.ParseOnly, "parse", false, "Parse MRPC file and output a JSON spec for further processing.")
 	flag.StringVar(&options.Target, "gen", "", "Generate an implementation for the specified target.")
 	flag.StringVar(&options.OutputDir, "out", "", "Output directory or filename. Defaults to STDOUT.")
+	flag.StringVar(&options.TemplatesDir, "templates", "", "Directory of templates replacing or adding to the generator's own.")
 	flag.Parse()
 
 	options.OutputDir = strings.TrimSpace(options.OutputDir)
+	options.TemplatesDir = strings.TrimSpace(options.TemplatesDir)
 	options.Target = strings.TrimSpace(options.Target)
 	for _, arg := range flag.Args() {
 		options.SpecFilenames = append(options.SpecFilenames, normalizeFilename(arg))
@@ -51,6 +56,8 @@ func (opts Options) validate() error {
 		return ErrNoGenTarget
 	case genMode && opts.OutputDir == "":
 		return ErrNoOutput
+	case genMode && opts.TemplatesDir != "" && !generator.Templated(opts.Target):
+		return errors.New("target `" + opts.Target + "` has no templates for -templates to replace")
 	default:
 		return nil
 	}
@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"github.com/chakrit/rpc/lexer"
+	"github.com/chakrit/rpc/spec"
+)
+
+// parseAnnotations parses consecutive annotations and holds them until the definition
+// that follows takes them with p.Annotations()
+func (p *parser) parseAnnotations() error {
+	start := p.pos
+	for p.Peek().Type == lexer.T_AnnotationMarker {
+		if annotation, err := p.parseAnnotation(); err != nil {
+			return err
+		} else {
+			p.annotations = append(p.annotations, annotation)
+		}
+	}
+
+	// doc comments above the annotations belong to the annotated definition
+	if doc := p.docs[start]; doc != "" && p.Doc() == "" {
+		p.docs[p.pos] = doc
+	}
+	return nil
+}
+
+func (p *parser) parseAnnotation() (*spec.Annotation, error) {
+	t := p.Peek()
+	p.Precond(t.Type == lexer.T_AnnotationMarker, "expecting `@` to start annotation")
+
+	_, ident := p.Consume()
+	if ident.Type&(lexer.T_Identifier|lexer.T_Keyword) == 0 {
+		return nil, p.Fail("annotation name expected")
+	}
+
+	annotation := &spec.Annotation{Name: ident.Value, Pos: ident.Pos}
+	if _, open := p.Consume(); open.Type != lexer.T_ArgListStart {
+		return annotation, nil
+	}
+
+	p.Consume()
+	if err := p.parseAnnotation_Args(annotation); err != nil {
+		return nil, err
+	}
+	return annotation, nil
+}
+
+func (p *parser) parseAnnotation_Args(annotation *spec.Annotation) error {
+	for {
+		t := p.Peek()
+		switch {
+		case t.Type == lexer.T_ArgListEnd:
+			p.Consume()
+			return nil
+
+		case t.Type&(lexer.T_StringValue|lexer.T_NumberValue) > 0:
+			if len(annotation.Params) > 0 {
+				return p.Fail("positional annotation arguments must come before named ones")
+			}
+			annotation.Args = append(annotation.Args, t.Value)
+			p.Consume()
+
+		case t.Type&(lexer.T_Identifier|lexer.T_Keyword) > 0:
+			if _, assign := p.Consume(); assign.Type != lexer.T_Assign {
+				return p.Fail("`=` expected after annotation argument name")
+			}
+			if _, value := p.Consume(); value.Type&(lexer.T_StringValue|lexer.T_NumberValue) == 0 {
+				return p.Fail("annotation argument value literal expected")
+			}
+
+			if annotation.Params == nil {
+				annotation.Params = map[string]string{}
+			} else if _, exists := annotation.Params[t.Value]; exists {
+				return p.Fail("duplicate annotation argument `" + t.Value + "`")
+			}
+			annotation.Params[t.Value] = p.Peek().Value
+			p.Consume()
+
+		default:
+			return p.Fail("annotation argument expected")
+		}
+
+		t = p.Peek()
+		switch t.Type {
+		case lexer.T_ArgListSep:
+			p.Consume()
+		case lexer.T_ArgListEnd:
+			p.Consume()
+			return nil
+		default:
+			return p.Fail("more annotation arguments with `,` or closing `)` expected")
+		}
+	}
+}
+
+// Annotations returns annotations parsed since the last call, for the current definition.
+func (p *parser) Annotations() spec.Annotations {
+	annotations := p.annotations
+	p.annotations = nil
+	return annotations
+}
@@ -5,29 +5,29 @@ import (
 	"github.com/chakrit/rpc/spec"
 )
 
-func (p *parser) parseBlockStart(scope string) (string, error) {
+func (p *parser) parseBlockStart(scope string) (*lexer.Token, error) {
 	t := p.Peek()
 	p.Precond(t.Value == scope, "expecting `"+scope+"` keyword")
 
 	_, ident := p.Consume()
 	if ident.Type != lexer.T_Identifier {
-		return "", p.Fail(scope + " name expected")
+		return nil, p.Fail(scope + " name expected")
 	}
 
 	_, open := p.Consume()
 	if open.Type != lexer.T_BlockStart {
-		return "", p.Fail("opening brace for " + scope + " `{` expected")
+		return nil, p.Fail("opening brace for " + scope + " `{` expected")
 	}
 
 	p.Consume()
-	return ident.Value, nil
+	return ident, nil
 }
 
 func (p *parser) parseTypeRef(scope string) (*spec.TypeRef, error) {
 	t := p.Peek()
 	p.Precond(t.Type&(lexer.T_Identifier|lexer.T_Keyword) > 0, "expecting identifier or keyword")
 
-	ref := &spec.TypeRef{Name: t.Value}
+	ref := &spec.TypeRef{Name: t.Value, Pos: t.Pos}
 	p.Consume()
 
 	t = p.Peek()
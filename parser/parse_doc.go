@@ -0,0 +1,56 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/chakrit/rpc/lexer"
+)
+
+// extractDocs removes comment tokens from the token stream and collects the ones that
+// sit on their own lines directly above a token, keyed by that token's index in the
+// returned stream.
+func extractDocs(tokens []*lexer.Token) ([]*lexer.Token, map[int]string) {
+	var (
+		result   []*lexer.Token
+		docs     = map[int]string{}
+		lines    []string
+		lastLine = -1
+		prevLine = -1
+	)
+
+	for _, t := range tokens {
+		if t.Type != lexer.T_Comment {
+			if len(lines) > 0 && t.Pos.Line == lastLine+1 {
+				docs[len(result)] = strings.Join(lines, "\n")
+			}
+
+			lines, prevLine = nil, t.Pos.Line
+			result = append(result, t)
+			continue
+		}
+
+		switch {
+		case t.Pos.Line == prevLine: // trailing comment after some definition
+			lines = nil
+		case len(lines) > 0 && t.Pos.Line != lastLine+1: // blank line breaks the block
+			lines = nil
+			fallthrough
+		default:
+			lines = append(lines, docLine(t.Value))
+		}
+		lastLine = t.Pos.Line
+	}
+
+	return result, docs
+}
+
+func docLine(comment string) string {
+	line := strings.TrimPrefix(comment, "//")
+	line = strings.TrimPrefix(line, " ")
+	return strings.TrimRight(line, " \t")
+}
+
+// Doc returns the doc comment attached to the current token, if any.
+func (p *parser) Doc() string {
+	return p.docs[p.pos]
+}
@@ -6,12 +6,18 @@ import (
 )
 
 func (p *parser) parseEnum() (*spec.Enum, error) {
-	name, err := p.parseBlockStart("enum")
+	doc := p.Doc()
+	ident, err := p.parseBlockStart("enum")
 	if err != nil {
 		return nil, err
 	}
 
-	enum := &spec.Enum{Name: name}
+	enum := &spec.Enum{
+		Name:        ident.Value,
+		Pos:         ident.Pos,
+		Doc:         doc,
+		Annotations: p.Annotations(),
+	}
 	if err := p.parseEnum_Members(enum); err != nil {
 		return nil, err
 	}
@@ -30,7 +36,19 @@ func (p *parser) parseEnum_Members(enum *spec.Enum) error {
 		switch t.Type {
 		case lexer.T_Keyword, lexer.T_Identifier:
 			enum.Members = append(enum.Members, t.Value)
-			p.Consume()
+			enum.MemberPositions = append(enum.MemberPositions, t.Pos)
+			if doc := p.Doc(); doc != "" {
+				if enum.MemberDocs == nil {
+					enum.MemberDocs = map[string]string{}
+				}
+				enum.MemberDocs[t.Value] = doc
+			}
+
+			if _, next := p.Consume(); next.Type == lexer.T_Assign {
+				if err := p.parseEnum_Value(enum, t.Value); err != nil {
+					return err
+				}
+			}
 		case lexer.T_BlockEnd:
 			return nil
 		case lexer.T_EndOfFile:
@@ -40,3 +58,25 @@ func (p *parser) parseEnum_Members(enum *spec.Enum) error {
 		}
 	}
 }
+
+func (p *parser) parseEnum_Value(enum *spec.Enum, member string) error {
+	assign := p.Peek()
+	p.Precond(assign.Type == lexer.T_Assign, "expecting `=` before enum value")
+
+	_, value := p.Consume()
+	if value.Type&(lexer.T_StringValue|lexer.T_NumberValue) == 0 {
+		return p.Fail("enum value literal expected")
+	}
+
+	isInteger := value.Type == lexer.T_NumberValue
+	if len(enum.Values) > 0 && enum.Integer != isInteger {
+		return p.Fail("enum values must be either all strings or all integers")
+	}
+
+	if enum.Values == nil {
+		enum.Values = map[string]string{}
+	}
+	enum.Values[member], enum.Integer = value.Value, isInteger
+	p.Consume()
+	return nil
+}
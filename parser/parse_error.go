@@ -0,0 +1,31 @@
+package parser
+
+import (
+	"github.com/chakrit/rpc/lexer"
+	"github.com/chakrit/rpc/spec"
+)
+
+func (p *parser) parseError() (*spec.Error, error) {
+	doc := p.Doc()
+	ident, err := p.parseBlockStart("error")
+	if err != nil {
+		return nil, err
+	}
+
+	e := &spec.Error{
+		Name:        ident.Value,
+		Pos:         ident.Pos,
+		Doc:         doc,
+		Annotations: p.Annotations(),
+	}
+	if err := p.parseProperties("error", &e.Properties); err != nil {
+		return nil, err
+	}
+
+	closing, _ := p.Consume()
+	if closing.Type != lexer.T_BlockEnd {
+		return nil, p.Fail("closing bracket for error{} expected")
+	}
+
+	return e, nil
+}
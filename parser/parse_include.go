@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/chakrit/rpc/lexer"
+	"github.com/chakrit/rpc/spec"
+)
+
+func (p *parser) parseInclude() (*spec.Namespace, error) {
+	t := p.Peek()
+	p.Precond(t.Value == "include", "expecting `include` keyword")
+
+	_, tpath := p.Consume()
+	if tpath.Type != lexer.T_StringValue {
+		return nil, p.Fail("include path string expected")
+	}
+
+	// included paths are always relative to the including file, or the current working
+	// directory when reading from STDIN.
+	filename := tpath.Value
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(filepath.Dir(p.filename), filename)
+	}
+
+	abspath, err := filepath.Abs(filename)
+	if err != nil {
+		return nil, p.Fail(err.Error())
+	}
+	for _, parent := range p.includes {
+		if parent == abspath {
+			return nil, p.Fail("include cycle detected, `" + filename + "` is already being included")
+		}
+	}
+
+	// a file included more than once into the same namespace, such as a common file
+	// included by several others, is only merged the first time.
+	if !p.markIncluded(abspath) {
+		p.Consume()
+		return &spec.Namespace{}, nil
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, p.Fail(err.Error())
+	}
+	defer file.Close()
+
+	ns, err := parse(Options{
+		Input:    file,
+		Filename: filename,
+		Logger:   p.logger,
+	}, p.includes, p.scope, p.included)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Consume()
+	return ns, nil
+}
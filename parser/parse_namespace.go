@@ -1,12 +1,13 @@
 package parser
 
 import (
+	"github.com/chakrit/rpc/internal"
 	"github.com/chakrit/rpc/lexer"
 	"github.com/chakrit/rpc/spec"
 )
 
 func (p *parser) parseRoot() (*spec.Namespace, error) {
-	ns := &spec.Namespace{Name: "root"}
+	ns := &spec.Namespace{Name: "root", Pos: internal.Pos{File: p.filename}}
 	if err := p.parseNamespace_Content(ns); err != nil {
 		return nil, err
 	} else {
@@ -15,12 +16,16 @@ func (p *parser) parseRoot() (*spec.Namespace, error) {
 }
 
 func (p *parser) parseNamespace() (*spec.Namespace, error) {
-	name, err := p.parseBlockStart("namespace")
+	doc := p.Doc()
+	ident, err := p.parseBlockStart("namespace")
 	if err != nil {
 		return nil, err
 	}
 
-	ns := &spec.Namespace{Name: name}
+	ns := &spec.Namespace{Name: ident.Value, Pos: ident.Pos, Doc: doc}
+	p.scope = append(p.scope[:len(p.scope):len(p.scope)], ns.Name)
+	defer func() { p.scope = p.scope[:len(p.scope)-1] }()
+
 	if err := p.parseNamespace_Content(ns); err != nil {
 		return nil, err
 	}
@@ -39,6 +44,17 @@ func (p *parser) parseNamespace_Content(ns *spec.Namespace) error {
 		switch t.Type {
 		case lexer.T_Keyword:
 			// continue to keyword switch
+		case lexer.T_AnnotationMarker:
+			if err := p.parseAnnotations(); err != nil {
+				return err
+			}
+
+			next := p.Peek()
+			if next.Type != lexer.T_Keyword ||
+				(next.Value != "type" && next.Value != "enum" && next.Value != "error" && next.Value != "rpc") {
+				return p.Fail("type, enum, error or rpc definition expected after annotations")
+			}
+			continue
 		case lexer.T_BlockEnd, lexer.T_EndOfFile:
 			return nil
 		default:
@@ -53,6 +69,18 @@ func (p *parser) parseNamespace_Content(ns *spec.Namespace) error {
 				ns.Children.Add(child)
 			}
 
+		case "include":
+			if included, err := p.parseInclude(); err != nil {
+				return err
+			} else {
+				// options of the including file take precedence over those it includes,
+				// whether they are set before or after the include
+				for key := range ns.Options {
+					delete(included.Options, key)
+				}
+				ns.Merge(included)
+			}
+
 		case "option":
 			key, value, err := p.parseOption()
 			if err != nil {
@@ -61,7 +89,10 @@ func (p *parser) parseNamespace_Content(ns *spec.Namespace) error {
 			if ns.Options == nil {
 				ns.Options = map[string]interface{}{}
 			}
-			ns.Options[key] = value
+			if ns.OptionPositions == nil {
+				ns.OptionPositions = map[string]internal.Pos{}
+			}
+			ns.Options[key], ns.OptionPositions[key] = value, t.Pos
 
 		case "type":
 			if typ, err := p.parseType(); err != nil {
@@ -77,6 +108,13 @@ func (p *parser) parseNamespace_Content(ns *spec.Namespace) error {
 				ns.Enums.Add(enum)
 			}
 
+		case "error":
+			if e, err := p.parseError(); err != nil {
+				return err
+			} else {
+				ns.Errors.Add(e)
+			}
+
 		case "rpc":
 			if r, err := p.parseRPC(); err != nil {
 				return err
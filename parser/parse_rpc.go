@@ -6,7 +6,7 @@ import (
 )
 
 func (p *parser) parseRPC() (*spec.RPC, error) {
-	t := p.Peek()
+	t, doc := p.Peek(), p.Doc()
 	p.Precond(t.Value == "rpc", "expecting `rpc` context")
 
 	_, ident := p.Consume()
@@ -19,20 +19,27 @@ func (p *parser) parseRPC() (*spec.RPC, error) {
 		return nil, p.Fail("start of argument list `(` expected")
 	}
 
-	rpc := &spec.RPC{Name: ident.Value}
+	rpc := &spec.RPC{
+		Name:        ident.Value,
+		Pos:         ident.Pos,
+		Doc:         doc,
+		Annotations: p.Annotations(),
+	}
 	p.Consume()
 
 	if err := p.parseRPC_InputArgs(rpc); err != nil {
 		return nil, err
 	} else if err := p.parseRPC_OutputArgs(rpc); err != nil {
 		return nil, err
+	} else if err := p.parseRPC_Throws(rpc); err != nil {
+		return nil, err
 	}
 
 	return rpc, nil
 }
 
 func (p *parser) parseRPC_InputArgs(rpc *spec.RPC) error {
-	rpc.InputTypes = nil
+	rpc.InputTypes, rpc.InputNames = nil, nil
 
 	for {
 		t := p.Peek()
@@ -52,6 +59,14 @@ func (p *parser) parseRPC_InputArgs(rpc *spec.RPC) error {
 			rpc.InputTypes = append(rpc.InputTypes, ref)
 		}
 
+		// argument names are optional, unnamed arguments are recorded as ""
+		name := ""
+		if t = p.Peek(); t.Type&(lexer.T_Identifier|lexer.T_Keyword) > 0 {
+			name = t.Value
+			p.Consume()
+		}
+		rpc.InputNames = append(rpc.InputNames, name)
+
 		t = p.Peek()
 		switch t.Type {
 		case lexer.T_ArgListSep:
@@ -67,6 +82,19 @@ func (p *parser) parseRPC_InputArgs(rpc *spec.RPC) error {
 
 func (p *parser) parseRPC_OutputArgs(r *spec.RPC) error {
 	r.OutputTypes = nil
+
+	t := p.Peek()
+	switch {
+	case t.Type == lexer.T_ArgListStart:
+		p.Consume()
+		return p.parseRPC_OutputTuple(r)
+	case t.Type == lexer.T_Identifier,
+		t.Type == lexer.T_Keyword && lexer.IsBuiltinType(t.Value):
+		// continue
+	default:
+		return nil // no return clause, void rpc
+	}
+
 	if ref, err := p.parseTypeRef("rpc"); err != nil {
 		return err
 	} else {
@@ -74,3 +102,58 @@ func (p *parser) parseRPC_OutputArgs(r *spec.RPC) error {
 		return nil
 	}
 }
+
+func (p *parser) parseRPC_OutputTuple(r *spec.RPC) error {
+	for {
+		t := p.Peek()
+		switch t.Type {
+		case lexer.T_Identifier, lexer.T_Keyword:
+			// continue
+		case lexer.T_ArgListEnd:
+			p.Consume()
+			return nil
+		default:
+			return p.Fail("return types expected")
+		}
+
+		if ref, err := p.parseTypeRef("rpc"); err != nil {
+			return err
+		} else {
+			r.OutputTypes = append(r.OutputTypes, ref)
+		}
+
+		t = p.Peek()
+		switch t.Type {
+		case lexer.T_ArgListSep:
+			p.Consume()
+		case lexer.T_ArgListEnd:
+			p.Consume()
+			return nil
+		default:
+			return p.Fail("more return types with `,` or closing bracket `)` expected")
+		}
+	}
+}
+
+func (p *parser) parseRPC_Throws(r *spec.RPC) error {
+	r.Errors = nil
+
+	t := p.Peek()
+	if t.Type != lexer.T_Keyword || t.Value != "throws" {
+		return nil // rpc declares no errors
+	}
+
+	p.Consume()
+	for {
+		t := p.Peek()
+		if t.Type != lexer.T_Identifier {
+			return p.Fail("error name expected")
+		}
+
+		r.Errors = append(r.Errors, &spec.TypeRef{Name: t.Value, Pos: t.Pos})
+		if _, next := p.Consume(); next.Type != lexer.T_ArgListSep {
+			return nil
+		}
+		p.Consume()
+	}
+}
@@ -6,13 +6,19 @@ import (
 )
 
 func (p *parser) parseType() (*spec.Type, error) {
-	name, err := p.parseBlockStart("type")
+	doc := p.Doc()
+	ident, err := p.parseBlockStart("type")
 	if err != nil {
 		return nil, err
 	}
 
-	typ := &spec.Type{Name: name}
-	if err := p.parseType_Content(typ); err != nil {
+	typ := &spec.Type{
+		Name:        ident.Value,
+		Pos:         ident.Pos,
+		Doc:         doc,
+		Annotations: p.Annotations(),
+	}
+	if err := p.parseProperties("type", &typ.Properties); err != nil {
 		return nil, err
 	}
 
@@ -24,21 +30,30 @@ func (p *parser) parseType() (*spec.Type, error) {
 	return typ, nil
 }
 
-func (p *parser) parseType_Content(typ *spec.Type) error {
+func (p *parser) parseProperties(scope string, props *spec.Mappings) error {
 	for {
 		t := p.Peek()
 		switch t.Type {
 		case lexer.T_Keyword, lexer.T_Identifier:
 			// continue
+		case lexer.T_AnnotationMarker:
+			if err := p.parseAnnotations(); err != nil {
+				return err
+			}
+			if next := p.Peek(); next.Type&(lexer.T_Keyword|lexer.T_Identifier) == 0 {
+				return p.Fail("property definition expected after annotations")
+			}
+			continue
 		case lexer.T_BlockEnd:
 			return nil
 		case lexer.T_EndOfFile:
-			return p.Fail("missing closing brace for type{}")
+			return p.Fail("missing closing brace for " + scope + "{}")
 		default:
 			return p.Fail("property definition expected")
 		}
 
-		typeref, err := p.parseTypeRef("type")
+		doc := p.Doc()
+		typeref, err := p.parseTypeRef(scope)
 		if err != nil {
 			return err
 		}
@@ -51,13 +66,16 @@ func (p *parser) parseType_Content(typ *spec.Type) error {
 		prop := &spec.Property{
 			Name: ident.Value,
 			Type: typeref,
+			Pos:  ident.Pos,
+			Doc:  doc,
+
+			Annotations: p.Annotations(),
 		}
-		_, isNew := typ.Properties.AddIfNew(prop)
+		_, isNew := props.AddIfNew(prop)
 		if !isNew {
 			return p.Fail("duplicate declaration for property `" + prop.Name + "`")
 		}
 
-		typ.Properties[prop.Name] = prop
 		p.Consume()
 	}
 }
@@ -3,6 +3,8 @@ package parser
 import (
 	"fmt"
 	"io"
+	"path/filepath"
+	"strings"
 
 	"github.com/chakrit/rpc/internal"
 
@@ -13,30 +15,65 @@ import (
 )
 
 type Options struct {
-	Input  io.Reader
-	Logger internal.Logger
+	Input    io.Reader
+	Filename string
+	Logger   internal.Logger
+}
+
+// inclusion is a file merged into a namespace, given by the names of the namespaces
+// leading to it.
+type inclusion struct {
+	scope    string
+	filename string
 }
 
 type parser struct {
-	logger internal.Logger
-	tokens []*lexer.Token
-	debug  bool
-	pos    int
+	logger   internal.Logger
+	filename string
+	includes []string
+	scope    []string
+	included map[inclusion]bool
+	tokens   []*lexer.Token
+	docs     map[int]string
+	debug    bool
+
+	annotations spec.Annotations
+	pos         int
 }
 
 func Parse(opts Options) (*spec.Namespace, error) {
+	return parse(opts, nil, nil, map[inclusion]bool{})
+}
+
+func parse(opts Options, includes, scope []string, included map[inclusion]bool) (*spec.Namespace, error) {
+	// track absolute paths of files currently being parsed to detect include cycles
+	if opts.Filename != "" {
+		if abspath, err := filepath.Abs(opts.Filename); err != nil {
+			return nil, err
+		} else {
+			includes = append(includes[:len(includes):len(includes)], abspath)
+		}
+	}
+
 	tokens, err := lexer.Lex(lexer.Options{
 		Input:       opts.Input,
+		Filename:    opts.Filename,
 		Logger:      opts.Logger,
-		IgnoreTypes: lexer.T_Space + lexer.T_EndOfLine + lexer.T_Comment,
+		IgnoreTypes: lexer.T_Space + lexer.T_EndOfLine,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	tokens, docs := extractDocs(tokens)
 	p := &parser{
-		logger: opts.Logger,
-		tokens: tokens,
+		logger:   opts.Logger,
+		filename: opts.Filename,
+		includes: includes,
+		scope:    scope,
+		included: included,
+		tokens:   tokens,
+		docs:     docs,
 	}
 	ns, err := p.parseRoot()
 	if err != nil {
@@ -83,3 +120,15 @@ func (p *parser) Consume() (*lexer.Token, *lexer.Token) {
 		return t, p.Peek()
 	}
 }
+
+// markIncluded records that the file is merged into the current namespace, and returns
+// false if it already was.
+func (p *parser) markIncluded(abspath string) bool {
+	key := inclusion{scope: strings.Join(p.scope, "."), filename: abspath}
+	if p.included[key] {
+		return false
+	}
+
+	p.included[key] = true
+	return true
+}
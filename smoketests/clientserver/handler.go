@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"errors"
 
 	"github.com/chakrit/rpc-todo/api"
 	"github.com/chakrit/rpc-todo/api/server"
@@ -20,8 +19,6 @@ type handler struct {
 	items []*api.TodoItem
 }
 
-var errNotFound = errors.New("item not found")
-
 var _ api.Interface = &handler{}
 
 func (h *handler) Destroy(ctx context.Context, id string) (*api.TodoItem, error) {
@@ -32,7 +29,27 @@ func (h *handler) Destroy(ctx context.Context, id string) (*api.TodoItem, error)
 		}
 	}
 
-	return nil, errNotFound
+	return nil, &api.TodoMissing{ID: id}
+}
+
+func (h *handler) Stats(ctx context.Context) (int, int, error) {
+	done := 0
+	for _, item := range h.items {
+		if item.Done {
+			done += 1
+		}
+	}
+
+	return len(h.items), done, nil
+}
+
+func (h *handler) Crash(ctx context.Context) error {
+	panic("crashed")
+}
+
+func (h *handler) Clear(ctx context.Context) error {
+	h.items = nil
+	return nil
 }
 
 func (h *handler) List(ctx context.Context) ([]*api.TodoItem, error) {
@@ -46,7 +63,7 @@ func (h *handler) Retrieve(ctx context.Context, id string) (*api.TodoItem, error
 		}
 	}
 
-	return nil, errNotFound
+	return nil, &api.TodoMissing{ID: id}
 }
 
 func (h *handler) Update(ctx context.Context, id string, item *api.TodoItem) (*api.TodoItem, error) {
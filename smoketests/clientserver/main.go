@@ -5,10 +5,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
-	"os"
+	"net"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/chakrit/rpc-todo/api"
@@ -21,26 +24,65 @@ func main() {
 	flag.StringVar(&addr, "a", "0.0.0.0:9999", "address to bind")
 	flag.Parse()
 
-	go runServer(addr)
-	time.Sleep(100 * time.Millisecond) // wait for server to be ready
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	srv := newServer(addr)
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(listener) }()
+
 	runClient(addr)
-	time.Sleep(300 * time.Millisecond) // wait for server to cleanup
+	checkRequests("http://" + addr + "/todo")
 
-	os.Exit(0) // also kills server routine
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Fatal(err)
+	} else if err := <-served; err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+	fmt.Println("Shutdown")
 }
 
-func runServer(addr string) {
-	opts := server.Options{Addr: addr}
+func newServer(addr string) *server.Server {
+	audit := func(ctx context.Context, info *server.MethodInfo, next server.Handler) ([]interface{}, error) {
+		if info.Annotations.Has("audit") {
+			fmt.Printf("audit: %s %v\n", info.Method(), info.Args)
+		}
+		return next(ctx, info)
+	}
+
+	opts := server.Options{
+		Addr:         addr,
+		Prefix:       "/todo",
+		Interceptors: []server.Interceptor{audit},
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		MaxBodyBytes: 1024,
+	}
 	srv := server.New(&opts)
 	srv.Provider = &provider{}
-	if err := srv.Listen(); err != nil {
-		log.Fatal(err)
-	}
+	return srv
 }
 
 func runClient(addr string) {
+	var calls, tagged int
+	countCalls := func(ctx context.Context, method string, req *http.Request, next client.Invoker) (*http.Response, error) {
+		calls += 1
+		if req.Header.Get("X-Request-Id") != "" {
+			tagged += 1
+		}
+		return next(ctx, method, req)
+	}
+
 	var (
-		opts = client.Options{Addr: addr}
+		opts = client.Options{
+			BaseURL:      "http://" + addr + "/todo/",
+			Timeout:      time.Second,
+			Interceptors: []client.Interceptor{countCalls},
+		}
 		cl   = client.New(&opts)
 		ctx  = context.Background()
 	)
@@ -71,16 +113,32 @@ func runClient(addr string) {
 		logOutput("List", items...)
 	}
 
+	if total, done, err := cl.Stats(ctx); err != nil {
+		log.Fatal(err)
+	} else {
+		fmt.Printf("Stats\n%d total, %d done\n", total, done)
+	}
+
 	if item, err := cl.Destroy(ctx, "alpha"); err != nil {
 		log.Fatal(err)
 	} else {
 		logOutput("Destroy", item)
 	}
 
-	if item, err := cl.Destroy(ctx, "beta"); err != nil {
+	var (
+		missing  *api.TodoMissing
+		rpcErr   *client.RPCError
+	)
+	if _, err := cl.Retrieve(ctx, "alpha"); !errors.As(err, &missing) || !errors.As(err, &rpcErr) {
+		log.Fatal("expected TodoMissing, got: ", err)
+	} else {
+		fmt.Printf("Retrieve\n%d %s: %s: %s\n", rpcErr.Status, rpcErr.Code, missing, missing.ID)
+	}
+
+	if err := cl.Clear(client.WithHeader(ctx, "X-Request-Id", "clear")); err != nil {
 		log.Fatal(err)
 	} else {
-		logOutput("Destroy", item)
+		logOutput("Clear")
 	}
 
 	if items, err := cl.List(ctx); err != nil {
@@ -88,6 +146,45 @@ func runClient(addr string) {
 	} else {
 		logOutput("List", items...)
 	}
+
+	if err := cl.Crash(ctx); !errors.As(err, &rpcErr) {
+		log.Fatal("expected RPCError, got: ", err)
+	} else {
+		fmt.Printf("Crash\n%d %s: %s\n", rpcErr.Status, rpcErr.Code, rpcErr.Message)
+	}
+
+	large := &api.TodoItem{Description: strings.Repeat("large", 1024)}
+	if _, err := cl.Update(ctx, "large", large); !errors.As(err, &rpcErr) {
+		log.Fatal("expected RPCError, got: ", err)
+	} else {
+		fmt.Printf("Update\n%d %s: %s\n", rpcErr.Status, rpcErr.Code, rpcErr.Message)
+	}
+
+	fmt.Printf("Calls\n%d total, %d with request id\n", calls, tagged)
+}
+
+func checkRequests(baseURL string) {
+	fmt.Println("Requests")
+	for _, check := range []struct{ method, contentType, body string }{
+		{"GET", "", ""},
+		{"POST", "text/plain", `["alpha"]`},
+		{"POST", "application/json", `[]`},
+		{"POST", "application/json", `["alpha", "beta"]`},
+		{"POST", "application/json; charset=utf-8", `["alpha"]`},
+	} {
+		req, err := http.NewRequest(check.method, baseURL+"/api/Retrieve", strings.NewReader(check.body))
+		if err != nil {
+			log.Fatal(err)
+		}
+		req.Header.Set("Content-Type", check.contentType)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			log.Fatal(err)
+		}
+		resp.Body.Close()
+		fmt.Printf("%s %q %s: %d\n", check.method, check.contentType, check.body, resp.StatusCode)
+	}
 }
 
 func logOutput(name string, items ...*api.TodoItem) {
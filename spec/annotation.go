@@ -0,0 +1,44 @@
+package spec
+
+import "github.com/chakrit/rpc/internal"
+
+// Annotation is a `@name(args...)` marker placed before a definition. Arguments are either
+// positional, as in `@deprecated("use V2")`, or named, as in `@http(method="GET")`.
+type Annotation struct {
+	Name   string            `json:"name"`
+	Pos    internal.Pos      `json:"pos"`
+	Args   []string          `json:"args,omitempty"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+type Annotations []*Annotation
+
+// Lookup returns the first annotation with the given name, or nil if there is none.
+func (a Annotations) Lookup(name string) *Annotation {
+	for _, annotation := range a {
+		if annotation.Name == name {
+			return annotation
+		}
+	}
+	return nil
+}
+
+func (a Annotations) Has(name string) bool {
+	return a.Lookup(name) != nil
+}
+
+// Arg returns the positional argument at the given index, or an empty string.
+func (a *Annotation) Arg(index int) string {
+	if a == nil || index >= len(a.Args) {
+		return ""
+	}
+	return a.Args[index]
+}
+
+// Param returns the named argument with the given key, or an empty string.
+func (a *Annotation) Param(key string) string {
+	if a == nil {
+		return ""
+	}
+	return a.Params[key]
+}
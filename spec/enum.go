@@ -1,29 +1,93 @@
 package spec
 
+import "github.com/chakrit/rpc/internal"
+
 type Enum struct {
-	Name    string   `json:"name"`
-	Members []string `json:"members"`
+	Name       string            `json:"name"`
+	Pos        internal.Pos      `json:"pos"`
+	Doc        string            `json:"doc,omitempty"`
+	Members    []string          `json:"members"`
+	MemberDocs map[string]string `json:"member_docs,omitempty"`
+
+	// MemberPositions holds the position of each of the Members, in the same order.
+	MemberPositions []internal.Pos `json:"member_positions,omitempty"`
+
+	// Values holds explicit wire values for members, which must all be integers when
+	// Integer is set. Members without one are sent as their dashed names.
+	Values  map[string]string `json:"values,omitempty"`
+	Integer bool              `json:"integer,omitempty"`
+
+	Annotations Annotations `json:"annotations,omitempty"`
 }
 
 var _ Node = &Enum{}
 var _ merger = &Enum{}
 
-func (e Enum) name() string { return e.Name }
-func (e Enum) node()        {}
+func (e *Enum) name() string { return e.Name }
+func (e *Enum) node()        {}
+
+func (e *Enum) HasMember(member string) bool {
+	for _, m := range e.Members {
+		if m == member {
+			return true
+		}
+	}
+	return false
+}
+
+// MemberPos returns the position of the member at the given index of Members, or that of
+// the enum when it is not known.
+func (e *Enum) MemberPos(idx int) internal.Pos {
+	if idx < len(e.MemberPositions) {
+		return e.MemberPositions[idx]
+	}
+	return e.Pos
+}
+
+// Value returns the wire value of the given member.
+func (e *Enum) Value(member string) string {
+	if value, ok := e.Values[member]; ok {
+		return value
+	}
+	return internal.InflectDash(member)
+}
 
-func (e Enum) Merge(node Node) Node {
+func (e *Enum) Merge(node Node) Node {
 	another, ok := node.(*Enum)
 	if !ok { // TODO: Warn
-		return another
+		return e
+	}
+
+	if e.Doc == "" {
+		e.Doc = another.Doc
+	}
+	e.Annotations = append(e.Annotations, another.Annotations...)
+	e.Integer = e.Integer || another.Integer
+	for member, value := range another.Values {
+		if _, exists := e.Values[member]; !exists {
+			if e.Values == nil {
+				e.Values = map[string]string{}
+			}
+			e.Values[member] = value
+		}
+	}
+	for member, doc := range another.MemberDocs {
+		if _, exists := e.MemberDocs[member]; !exists {
+			if e.MemberDocs == nil {
+				e.MemberDocs = map[string]string{}
+			}
+			e.MemberDocs[member] = doc
+		}
 	}
 
 	existing := map[string]struct{}{}
 	for _, member := range e.Members {
 		existing[member] = struct{}{}
 	}
-	for _, member := range another.Members {
+	for idx, member := range another.Members {
 		if _, exists := existing[member]; !exists {
 			e.Members = append(e.Members, member)
+			e.MemberPositions = append(e.MemberPositions, another.MemberPos(idx))
 		}
 	}
 	return e
@@ -0,0 +1,55 @@
+package spec
+
+import (
+	"strconv"
+
+	"github.com/chakrit/rpc/internal"
+)
+
+// Error is a structured error that RPCs may fail with. Its properties are sent along as
+// the error details.
+type Error struct {
+	Name       string       `json:"name"`
+	Pos        internal.Pos `json:"pos"`
+	Doc        string       `json:"doc,omitempty"`
+	Properties Mappings     `json:"properties"`
+
+	Annotations Annotations `json:"annotations,omitempty"`
+}
+
+var _ Node = &Error{}
+var _ merger = &Error{}
+
+func (e *Error) name() string { return e.Name }
+func (e *Error) node()        {}
+
+// Code returns the error code that identifies the error on the wire.
+func (e *Error) Code() string {
+	return internal.InflectSnake(e.Name)
+}
+
+// Status returns the HTTP status given with a `@status(code)` annotation, or 0 when the
+// error is left to the server's default mapping.
+func (e *Error) Status() int {
+	status, _ := strconv.Atoi(e.Annotations.Lookup("status").Arg(0))
+	return status
+}
+
+func (e *Error) Merge(node Node) Node {
+	another, ok := node.(*Error)
+	if !ok { // TODO: Warn about this
+		return e
+	}
+
+	if e.Doc == "" {
+		e.Doc = another.Doc
+	}
+	e.Annotations = append(e.Annotations, another.Annotations...)
+	if e.Properties == nil && len(another.Properties) > 0 {
+		e.Properties = Mappings{}
+	}
+	for name, prop := range another.Properties {
+		e.Properties[name] = prop
+	}
+	return e
+}
@@ -1,13 +1,21 @@
 package spec
 
+import "github.com/chakrit/rpc/internal"
+
 type Namespace struct {
 	Name     string                 `json:"name"`
+	Pos      internal.Pos           `json:"pos"`
+	Doc      string                 `json:"doc,omitempty"`
 	Children Mappings               `json:"children"`
 	Options  map[string]interface{} `json:"options"`
 
-	Types Mappings `json:"types"`
-	Enums Mappings `json:"enums"`
-	RPCs  Mappings `json:"rpcs"`
+	// OptionPositions holds where each of the Options was set.
+	OptionPositions map[string]internal.Pos `json:"option_positions,omitempty"`
+
+	Types  Mappings `json:"types"`
+	Enums  Mappings `json:"enums"`
+	Errors Mappings `json:"errors"`
+	RPCs   Mappings `json:"rpcs"`
 }
 
 var _ Node = &Namespace{}
@@ -16,6 +24,15 @@ var _ merger = &Namespace{}
 func (ns *Namespace) name() string { return ns.Name }
 func (ns *Namespace) node()        {}
 
+// OptionPos returns the position where the option was set, or that of the namespace when
+// it is not known.
+func (ns *Namespace) OptionPos(key string) internal.Pos {
+	if pos, ok := ns.OptionPositions[key]; ok {
+		return pos
+	}
+	return ns.Pos
+}
+
 func (ns *Namespace) Merge(node Node) Node {
 	another, ok := node.(*Namespace)
 	if !ok { // TODO: Warn about this.
@@ -23,11 +40,17 @@ func (ns *Namespace) Merge(node Node) Node {
 	}
 
 	if ns.Name == "" {
-		ns.Name = another.Name
+		ns.Name, ns.Pos = another.Name, another.Pos
+	}
+	if ns.Doc == "" {
+		ns.Doc = another.Doc
 	}
 	if ns.Options == nil && len(another.Options) > 0 {
 		ns.Options = map[string]interface{}{}
 	}
+	if ns.OptionPositions == nil && len(another.Options) > 0 {
+		ns.OptionPositions = map[string]internal.Pos{}
+	}
 
 	for _, child := range another.Children {
 		ns.Children.Add(child)
@@ -38,11 +61,14 @@ func (ns *Namespace) Merge(node Node) Node {
 	for _, enum := range another.Enums {
 		ns.Enums.Add(enum)
 	}
+	for _, e := range another.Errors {
+		ns.Errors.Add(e)
+	}
 	for _, rpc := range another.RPCs {
 		ns.RPCs.AddIfNew(rpc)
 	}
 	for key, value := range another.Options {
-		ns.Options[key] = value
+		ns.Options[key], ns.OptionPositions[key] = value, another.OptionPos(key)
 	}
 
 	return ns
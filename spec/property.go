@@ -1,8 +1,14 @@
 package spec
 
+import "github.com/chakrit/rpc/internal"
+
 type Property struct {
-	Name string   `json:"name"`
-	Type *TypeRef `json:"type"`
+	Name string       `json:"name"`
+	Pos  internal.Pos `json:"pos"`
+	Doc  string       `json:"doc,omitempty"`
+	Type *TypeRef     `json:"type"`
+
+	Annotations Annotations `json:"annotations,omitempty"`
 }
 
 var _ Node = &Property{}
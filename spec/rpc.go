@@ -1,9 +1,17 @@
 package spec
 
+import "github.com/chakrit/rpc/internal"
+
 type RPC struct {
-	Name        string     `json:"name"`
-	InputTypes  []*TypeRef `json:"input"`
-	OutputTypes []*TypeRef `json:"output"`
+	Name        string       `json:"name"`
+	Pos         internal.Pos `json:"pos"`
+	Doc         string       `json:"doc,omitempty"`
+	InputTypes  []*TypeRef   `json:"input"`
+	InputNames  []string     `json:"input_names"`
+	OutputTypes []*TypeRef   `json:"output"`
+	Errors      []*TypeRef   `json:"errors,omitempty"`
+
+	Annotations Annotations `json:"annotations,omitempty"`
 }
 
 var _ Node = &RPC{}
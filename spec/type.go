@@ -1,8 +1,14 @@
 package spec
 
+import "github.com/chakrit/rpc/internal"
+
 type Type struct {
-	Name       string   `json:"name"`
-	Properties Mappings `json:"properties"`
+	Name       string       `json:"name"`
+	Pos        internal.Pos `json:"pos"`
+	Doc        string       `json:"doc,omitempty"`
+	Properties Mappings     `json:"properties"`
+
+	Annotations Annotations `json:"annotations,omitempty"`
 }
 
 var _ Node = &Type{}
@@ -17,6 +23,10 @@ func (t *Type) Merge(node Node) Node {
 		return another
 	}
 
+	if t.Doc == "" {
+		t.Doc = another.Doc
+	}
+	t.Annotations = append(t.Annotations, another.Annotations...)
 	for name, prop := range another.Properties {
 		t.Properties[name] = prop
 	}
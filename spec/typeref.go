@@ -1,8 +1,11 @@
 package spec
 
+import "github.com/chakrit/rpc/internal"
+
 type TypeRef struct {
-	Name      string     `json:"name"`
-	Arguments []*TypeRef `json:"arguments"`
+	Name      string       `json:"name"`
+	Pos       internal.Pos `json:"pos"`
+	Arguments []*TypeRef   `json:"arguments"`
 }
 
 var _ Node = &TypeRef{}
@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"strconv"
+
+	"github.com/chakrit/rpc/spec"
+)
+
+// validateAnnotations checks the annotations that generators act upon. Other annotations
+// are left alone for custom templates to make use of.
+func (v *validator) validateAnnotations(annotations spec.Annotations, node spec.Node) {
+	for _, annotation := range annotations {
+		switch annotation.Name {
+		case "deprecated":
+			if len(annotation.Args) > 1 || len(annotation.Params) > 0 {
+				v.Fail(annotation.Pos, "annotation `@deprecated` takes at most one message argument")
+			}
+
+		case "json":
+			if _, isProperty := node.(*spec.Property); !isProperty {
+				v.Fail(annotation.Pos, "annotation `@json` is only valid on properties")
+			} else if annotation.Param("name") == "" {
+				v.Fail(annotation.Pos, "annotation `@json` requires a `name` argument")
+			}
+
+		case "fallback":
+			enum, isEnum := node.(*spec.Enum)
+			if !isEnum {
+				v.Fail(annotation.Pos, "annotation `@fallback` is only valid on enums")
+			} else if !enum.HasMember(annotation.Arg(0)) {
+				v.Fail(annotation.Pos, "fallback `%s` is not a member of enum `%s`",
+					annotation.Arg(0), enum.Name)
+			}
+
+		case "status":
+			if _, isError := node.(*spec.Error); !isError {
+				v.Fail(annotation.Pos, "annotation `@status` is only valid on errors")
+			} else if status, err := strconv.Atoi(annotation.Arg(0)); err != nil || status < 400 || status > 599 {
+				v.Fail(annotation.Pos, "annotation `@status` requires an HTTP error status, got `%s`",
+					annotation.Arg(0))
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package validator
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/chakrit/rpc/internal"
+	"github.com/chakrit/rpc/spec"
+)
+
+// positionalName matches the names generators give to unnamed rpc arguments.
+var positionalName = regexp.MustCompile(`^arg[0-9]+$`)
+
+// errorMethods are the methods generated errors have, which their properties must not
+// take the name of.
+var errorMethods = []string{"Error", "ErrorCode", "HTTPStatus"}
+
+// wellKnownCodes are the codes of errors not declared in the spec, which clients could not
+// tell apart from declared errors of the same code.
+var wellKnownCodes = []string{"invalid_argument", "unauthenticated", "permission_denied", "not_found", "internal"}
+
+func (v *validator) validateNamespace(ns *spec.Namespace) {
+	v.scopes = append(v.scopes, ns)
+	defer func() { v.scopes = v.scopes[:len(v.scopes)-1] }()
+
+	for _, node := range ns.Types.SortedByName() {
+		v.validateType(node.(*spec.Type))
+	}
+	for _, node := range ns.Enums.SortedByName() {
+		enum := node.(*spec.Enum)
+		if _, clash := ns.Types[enum.Name]; clash {
+			v.Fail(enum.Pos, "enum `%s` clashes with a type of the same name", enum.Name)
+		}
+
+		v.validateEnum(enum)
+	}
+	for _, node := range ns.Errors.SortedByName() {
+		e := node.(*spec.Error)
+		if _, clash := ns.Types[e.Name]; clash {
+			v.Fail(e.Pos, "error `%s` clashes with a type of the same name", e.Name)
+		} else if _, clash := ns.Enums[e.Name]; clash {
+			v.Fail(e.Pos, "error `%s` clashes with an enum of the same name", e.Name)
+		}
+
+		v.validateError(e)
+	}
+	for _, node := range ns.RPCs.SortedByName() {
+		v.validateRPC(node.(*spec.RPC))
+	}
+	for _, node := range ns.Children.SortedByName() {
+		v.validateNamespace(node.(*spec.Namespace))
+	}
+}
+
+func (v *validator) validateType(typ *spec.Type) {
+	v.validateAnnotations(typ.Annotations, typ)
+	v.validateProperties(typ.Properties)
+}
+
+func (v *validator) validateError(e *spec.Error) {
+	v.validateAnnotations(e.Annotations, e)
+	v.validateProperties(e.Properties)
+
+	for _, code := range wellKnownCodes {
+		if e.Code() == code {
+			v.Fail(e.Pos, "error `%s` has the well-known code `%s`", e.Name, code)
+		}
+	}
+
+	for _, node := range e.Properties.SortedByName() {
+		prop := node.(*spec.Property)
+		for _, method := range errorMethods {
+			if strings.EqualFold(internal.InflectPascal(prop.Name), method) {
+				v.Fail(prop.Pos, "property `%s` of error `%s` clashes with its `%s()` method",
+					prop.Name, e.Name, method)
+			}
+		}
+	}
+}
+
+func (v *validator) validateProperties(props spec.Mappings) {
+	jsonNames := map[string]string{}
+	for _, node := range props.SortedByName() {
+		prop := node.(*spec.Property)
+		v.validateAnnotations(prop.Annotations, prop)
+		v.validateTypeRef(prop.Type)
+
+		jsonName := prop.Name
+		if name := prop.Annotations.Lookup("json").Param("name"); name != "" {
+			jsonName = name
+		}
+		if other, clash := jsonNames[jsonName]; clash {
+			v.Fail(prop.Pos, "json name `%s` of property `%s` clashes with property `%s`",
+				jsonName, prop.Name, other)
+		}
+		jsonNames[jsonName] = prop.Name
+	}
+}
+
+func (v *validator) validateEnum(enum *spec.Enum) {
+	v.validateAnnotations(enum.Annotations, enum)
+
+	existing := map[string]struct{}{}
+	values := map[string]string{}
+	for idx, member := range enum.Members {
+		pos := enum.MemberPos(idx)
+		if _, exists := existing[member]; exists {
+			v.Fail(pos, "duplicate member `%s` in enum `%s`", member, enum.Name)
+			continue
+		}
+		existing[member] = struct{}{}
+
+		value, explicit := enum.Values[member]
+		switch {
+		case enum.Integer && !explicit:
+			v.Fail(pos, "member `%s` of integer enum `%s` needs a value", member, enum.Name)
+			continue
+		case enum.Integer:
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				v.Fail(pos, "value `%s` of member `%s` is not an integer", value, member)
+			}
+		default:
+			value = enum.Value(member)
+		}
+
+		if other, clash := values[value]; clash {
+			v.Fail(pos, "members `%s` and `%s` of enum `%s` have the same value `%s`",
+				other, member, enum.Name, value)
+		}
+		values[value] = member
+	}
+}
+
+func (v *validator) validateRPC(rpc *spec.RPC) {
+	v.validateAnnotations(rpc.Annotations, rpc)
+
+	// generators convert argument names to camelCase or snake_case, so names must stay
+	// distinct after either conversion, and not take the `argN` names of unnamed ones.
+	camels, snakes := map[string]string{}, map[string]string{}
+	for _, name := range rpc.InputNames {
+		if name == "" {
+			continue
+		}
+
+		camel, snake := internal.InflectCamel(name), internal.InflectSnake(name)
+		if positionalName.MatchString(camel) || positionalName.MatchString(snake) {
+			v.Fail(rpc.Pos, "argument name `%s` in rpc `%s` is reserved for unnamed arguments",
+				name, rpc.Name)
+		} else if other, exists := camels[camel]; exists && other == name {
+			v.Fail(rpc.Pos, "duplicate argument name `%s` in rpc `%s`", name, rpc.Name)
+		} else if exists {
+			v.Fail(rpc.Pos, "argument names `%s` and `%s` in rpc `%s` are the same as `%s`",
+				other, name, rpc.Name, camel)
+		} else if other, exists := snakes[snake]; exists {
+			v.Fail(rpc.Pos, "argument names `%s` and `%s` in rpc `%s` are the same as `%s`",
+				other, name, rpc.Name, snake)
+		}
+		camels[camel], snakes[snake] = name, name
+	}
+
+	for _, ref := range rpc.InputTypes {
+		v.validateTypeRef(ref)
+	}
+	for _, ref := range rpc.OutputTypes {
+		v.validateTypeRef(ref)
+	}
+
+	thrown := map[string]struct{}{}
+	for _, ref := range rpc.Errors {
+		if v.LookupError(ref.Name) == nil {
+			v.Fail(ref.Pos, "unknown error `%s`", ref.Name)
+		} else if _, exists := thrown[ref.Name]; exists {
+			v.Fail(ref.Pos, "duplicate error `%s` in rpc `%s`", ref.Name, rpc.Name)
+		}
+		thrown[ref.Name] = struct{}{}
+	}
+}
@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"github.com/chakrit/rpc/lexer"
+	"github.com/chakrit/rpc/spec"
+)
+
+func (v *validator) validateTypeRef(ref *spec.TypeRef) {
+	arity, isBuiltin := lexer.BuiltinTypes[ref.Name]
+	if !isBuiltin {
+		if v.Lookup(ref.Name) == nil {
+			v.Fail(ref.Pos, "unknown type `%s`", ref.Name)
+		}
+		arity = 0
+	}
+
+	if len(ref.Arguments) != arity {
+		v.Fail(ref.Pos, "type `%s` expects %d type argument(s), got %d",
+			ref.Name, arity, len(ref.Arguments))
+	}
+	if ref.Name == "map" && len(ref.Arguments) > 0 {
+		v.validateMapKey(ref.Arguments[0])
+	}
+	if ref.Name == "optional" && len(ref.Arguments) > 0 && ref.Arguments[0].Name == "optional" {
+		v.Fail(ref.Pos, "optional types cannot be nested")
+	}
+
+	for _, arg := range ref.Arguments {
+		v.validateTypeRef(arg)
+	}
+}
+
+func (v *validator) validateMapKey(key *spec.TypeRef) {
+	if key.Name == "string" {
+		return
+	}
+
+	switch node := v.Lookup(key.Name).(type) {
+	case *spec.Enum:
+		if node.Integer {
+			v.Fail(key.Pos, "map key must be a `string` or a string enum, got integer enum `%s`", key.Name)
+		}
+		return
+	case nil:
+		if _, isBuiltin := lexer.BuiltinTypes[key.Name]; !isBuiltin {
+			return // unknown types are already reported
+		}
+	}
+
+	v.Fail(key.Pos, "map key must be a `string` or an enum, got `%s`", key.Name)
+}
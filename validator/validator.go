@@ -0,0 +1,66 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/chakrit/rpc/internal"
+	"github.com/chakrit/rpc/spec"
+)
+
+// Error describes a semantic problem found in the spec, located at Pos.
+type Error struct {
+	Pos     internal.Pos
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Pos.String() + ": " + e.Message
+}
+
+type validator struct {
+	scopes []*spec.Namespace // innermost namespace last
+	errors []*Error
+}
+
+// Validate checks the fully merged spec for semantic errors that the parser cannot detect
+// on its own, such as references to unknown types. All errors found are returned, in
+// a stable order, so they can be reported in a single run.
+func Validate(ns *spec.Namespace) []*Error {
+	v := &validator{}
+	v.validateNamespace(ns)
+	return v.errors
+}
+
+func (v *validator) Fail(pos internal.Pos, format string, args ...interface{}) {
+	v.errors = append(v.errors, &Error{
+		Pos:     pos,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Lookup finds the user-defined type or enum with the given name, searching from the
+// current namespace outwards to the root, the same way the generators resolve names.
+func (v *validator) Lookup(name string) spec.Node {
+	for idx := len(v.scopes) - 1; idx >= 0; idx-- {
+		scope := v.scopes[idx]
+		if typ, ok := scope.Types[name]; ok {
+			return typ
+		} else if enum, ok := scope.Enums[name]; ok {
+			return enum
+		}
+	}
+
+	return nil
+}
+
+// LookupError finds the error declaration with the given name, searching outwards the
+// same way as Lookup.
+func (v *validator) LookupError(name string) *spec.Error {
+	for idx := len(v.scopes) - 1; idx >= 0; idx-- {
+		if e, ok := v.scopes[idx].Errors[name]; ok {
+			return e.(*spec.Error)
+		}
+	}
+
+	return nil
+}